@@ -2,9 +2,13 @@ package lever
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
 	"github.com/corbaltcode/lever-data-api-go/model"
 )
 
@@ -27,6 +31,12 @@ type ApplicationClientInterface interface {
 	// OpportunityClient ListAllOpportunities() method, specifying the relevant contact UID in the
 	// contact_id parameter and specifying the expand parameter to include applications.
 	ListApplications(ctx context.Context, req *ListApplicationsRequest) (*ListApplicationsResponse, error)
+
+	// Apply an existing opportunity to a posting.
+	//
+	// This method creates an application for the posting on an opportunity that has not yet been
+	// applied to a posting, and returns the new application record.
+	ApplyOpportunityToPosting(ctx context.Context, req *ApplyOpportunityToPostingRequest) (*ApplyOpportunityToPostingResponse, error)
 }
 
 // Parameters for retrieving a single application.
@@ -52,7 +62,7 @@ func (r *GetApplicationRequest) GetPath() string {
 	return fmt.Sprintf("opportunities/%s/applications/%s", url.PathEscape(r.OpportunityId), url.PathEscape(r.ApplicationId))
 }
 
-// Response for retrieving a single application.
+// Response for retrieving a single application; returned to client users.
 type GetApplicationResponse struct {
 	BaseResponse
 
@@ -60,6 +70,15 @@ type GetApplicationResponse struct {
 	Application *model.Application `json:"data"`
 }
 
+// JSON response type for retrieving a single application, with some field types dynamically
+// determined.
+type getApplicationResponseJSON struct {
+	BaseResponse
+
+	// The application record.
+	Application *multimodel.Application `json:"data"`
+}
+
 // Parameters for listing applications for a candidate.
 type ListApplicationsRequest struct {
 	BaseListRequest
@@ -79,7 +98,7 @@ func (r *ListApplicationsRequest) GetPath() string {
 	return fmt.Sprintf("opportunities/%s/applications", url.PathEscape(r.OpportunityId))
 }
 
-// Response for listing applications for a candidate.
+// Response for listing applications for a candidate; returned to client users.
 type ListApplicationsResponse struct {
 	BaseListResponse
 
@@ -87,6 +106,97 @@ type ListApplicationsResponse struct {
 	Applications []*model.Application `json:"data"`
 }
 
+// JSON response type for listing applications for a candidate, with some field types
+// dynamically determined.
+type listApplicationsResponseJSON struct {
+	BaseListResponse
+
+	// The application records.
+	Applications []multimodel.Application `json:"data"`
+}
+
+// Parameters for applying an existing opportunity to a posting.
+type ApplyOpportunityToPostingRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// Perform this apply on behalf of a specified user. The application will be attributed to this
+	// user. This is required.
+	PerformAsID string
+
+	// The posting to apply the opportunity to. This is required.
+	PostingID string
+}
+
+// Create a new ApplyOpportunityToPostingRequest with the required fields.
+func NewApplyOpportunityToPostingRequest(performAsID, opportunityID, postingID string) *ApplyOpportunityToPostingRequest {
+	return &ApplyOpportunityToPostingRequest{
+		OpportunityID: opportunityID,
+		PerformAsID:   performAsID,
+		PostingID:     postingID,
+	}
+}
+
+// Check that the required fields are present. All problems found are returned, joined with
+// [errors.Join].
+func (r *ApplyOpportunityToPostingRequest) Validate() error {
+	var errs []error
+
+	if r.PerformAsID == "" {
+		errs = append(errs, errors.New("perform as user id is required"))
+	}
+
+	if r.PostingID == "" {
+		errs = append(errs, errors.New("posting id is required"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *ApplyOpportunityToPostingRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/apply", url.PathEscape(r.OpportunityID))
+}
+
+func (r *ApplyOpportunityToPostingRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *ApplyOpportunityToPostingRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+// JSON body for the apply opportunity to posting request.
+type applyOpportunityToPostingRequestBody struct {
+	PostingID string `json:"postingId"`
+}
+
+func (r *ApplyOpportunityToPostingRequest) GetBody() (io.Reader, error) {
+	return encodeJSONBody(applyOpportunityToPostingRequestBody{PostingID: r.PostingID})
+}
+
+// Response for applying an existing opportunity to a posting; returned to client users.
+type ApplyOpportunityToPostingResponse struct {
+	BaseResponse
+
+	// The new application record.
+	Application *model.Application `json:"data"`
+}
+
+// JSON response type for applying an existing opportunity to a posting, with some field types
+// dynamically determined.
+type applyOpportunityToPostingResponseJSON struct {
+	BaseResponse
+
+	// The new application record.
+	Application *multimodel.Application `json:"data"`
+}
+
 // Retrieve a single application.
 //
 // This method returns the full application record for a single application.
@@ -95,11 +205,23 @@ type ListApplicationsResponse struct {
 // OpportunityClient GetOpportunity() method, specifying the expand parameter to include
 // applications.
 func (c *Client) GetApplication(ctx context.Context, req *GetApplicationRequest) (*GetApplicationResponse, error) {
-	var resp GetApplicationResponse
-	if err := c.exec(ctx, req, &resp); err != nil {
+	var respJSON getApplicationResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
 		return nil, err
 	}
 
+	// Convert the response to the client type
+	var application model.Application
+	err := respJSON.Application.ToModel(&application)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetApplicationResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Application:  &application,
+	}
+
 	return &resp, nil
 }
 
@@ -109,10 +231,55 @@ func (c *Client) GetApplication(ctx context.Context, req *GetApplicationRequest)
 // OpportunityClient ListAllOpportunities() method, specifying the relevant contact UID in the
 // contact_id parameter and specifying the expand parameter to include applications.
 func (c *Client) ListApplications(ctx context.Context, req *ListApplicationsRequest) (*ListApplicationsResponse, error) {
-	var resp ListApplicationsResponse
-	if err := c.exec(ctx, req, &resp); err != nil {
+	var respJSON listApplicationsResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
 		return nil, err
 	}
 
+	// Convert the response to the client type
+	applications := make([]*model.Application, len(respJSON.Applications))
+	for i := range respJSON.Applications {
+		applications[i] = &model.Application{}
+		err := respJSON.Applications[i].ToModel(applications[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListApplicationsResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		Applications:     applications,
+	}
+
+	return &resp, nil
+}
+
+// Apply an existing opportunity to a posting.
+//
+// This method creates an application for the posting on an opportunity that has not yet been
+// applied to a posting, and returns the new application record. Lever rejects the request if the
+// opportunity already has an application.
+func (c *Client) ApplyOpportunityToPosting(ctx context.Context, req *ApplyOpportunityToPostingRequest) (*ApplyOpportunityToPostingResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var respJSON applyOpportunityToPostingResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var application model.Application
+	err := respJSON.Application.ToModel(&application)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := ApplyOpportunityToPostingResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Application:  &application,
+	}
+
 	return &resp, nil
 }
@@ -1,6 +1,215 @@
 package lever
 
-import "testing"
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/stretchr/testify/assert"
+)
 
 func TestGetApplicationRequest(t *testing.T) {
+	ta := assert.New(t)
+
+	const applicationPath = "/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/applications/cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": applicationSeniorEngineer}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(applicationPath),
+			testclient.ExpectNoQuery(),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": expandApplication(applicationSeniorEngineer, "posting", "postingOwner", "postingHiringManager", "user")}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(applicationPath),
+			testclient.ExpectQuery("expand", "posting"),
+			testclient.ExpectQuery("expand", "postingOwner"),
+			testclient.ExpectQuery("expand", "postingHiringManager"),
+			testclient.ExpectQuery("expand", "user"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	req := NewGetApplicationRequest("250d8f03-738a-4bba-a671-8a3d73477145", "cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa")
+	resp, err := c.GetApplication(ctx, req)
+	if ta.NoError(err) && ta.NotNil(resp.Application) {
+		application := resp.Application
+		ta.Equal("cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa", application.ID)
+		ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", application.PostingID)
+		ta.Nil(application.Posting)
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", application.PostingOwnerID)
+		ta.Nil(application.PostingOwner)
+	}
+
+	// Expand all fields
+	req.Expand = []string{"posting", "postingOwner", "postingHiringManager", "user"}
+	resp, err = c.GetApplication(ctx, req)
+	if ta.NoError(err) && ta.NotNil(resp.Application) {
+		application := resp.Application
+		ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", application.PostingID)
+		if ta.NotNil(application.Posting) {
+			ta.Equal("Customer Success Manager", application.Posting.Text)
+		}
+
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", application.PostingOwnerID)
+		if ta.NotNil(application.PostingOwner) {
+			ta.Equal("Rachel Green", application.PostingOwner.Name)
+		}
+
+		ta.Equal("022d6639-1333-419b-9635-31f93015335f", application.PostingHiringManagerID)
+		if ta.NotNil(application.PostingHiringManager) {
+			ta.Equal("Monica Geller", application.PostingHiringManager.Name)
+		}
+
+		ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", application.UserID)
+		if ta.NotNil(application.User) {
+			ta.Equal("Chandler Bing", application.User.Name)
+		}
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestApplyOpportunityToPosting(t *testing.T) {
+	ta := assert.New(t)
+
+	const performAsID = "df0adaa6-172c-4cd6-8520-49b203660fe1"
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": applicationSeniorEngineer}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/apply"),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectBody(`{"postingId":"f2f01e16-27f8-4711-a728-7d49499795a0"}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusBadRequest,
+			toJSON(map[string]any{"code": "BadRequestError", "message": "Opportunity already has an application"}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/apply"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	req := NewApplyOpportunityToPostingRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "f2f01e16-27f8-4711-a728-7d49499795a0")
+	resp, err := c.ApplyOpportunityToPosting(ctx, req)
+	if ta.NoError(err) && ta.NotNil(resp.Application) {
+		ta.Equal("cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa", resp.Application.ID)
+		ta.Equal("250d8f03-738a-4bba-a671-8a3d73477145", resp.Application.OpportunityID)
+		ta.Equal("user", resp.Application.Type)
+		ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", resp.Application.PostingID)
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", resp.Application.PostingOwnerID)
+		ta.Equal("022d6639-1333-419b-9635-31f93015335f", resp.Application.PostingHiringManagerID)
+	}
+
+	// Lever errors are returned to the caller
+	_, err = c.ApplyOpportunityToPosting(ctx, req)
+	ta.Error(err)
+
+	// Required fields are checked before anything is sent
+	_, err = c.ApplyOpportunityToPosting(ctx, NewApplyOpportunityToPostingRequest("", "250d8f03-738a-4bba-a671-8a3d73477145", "f2f01e16-27f8-4711-a728-7d49499795a0"))
+	ta.Error(err)
+
+	_, err = c.ApplyOpportunityToPosting(ctx, NewApplyOpportunityToPostingRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", ""))
+	ta.Error(err)
+
+	err = NewApplyOpportunityToPostingRequest("", "250d8f03-738a-4bba-a671-8a3d73477145", "").Validate()
+	if ta.Error(err) {
+		ta.ErrorContains(err, "perform as user id is required")
+		ta.ErrorContains(err, "posting id is required")
+	}
+
+	ta.Empty(s.Expected)
+}
+
+var applicationSeniorEngineer = map[string]any{
+	"id":                   "cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa",
+	"opportunityId":        "250d8f03-738a-4bba-a671-8a3d73477145",
+	"candidateId":          "250d8f03-738a-4bba-a671-8a3d73477145",
+	"createdAt":            1417588008000,
+	"type":                 "user",
+	"posting":              "f2f01e16-27f8-4711-a728-7d49499795a0",
+	"postingOwner":         "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+	"postingHiringManager": "022d6639-1333-419b-9635-31f93015335f",
+	"user":                 "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"name":                 "Shane Smith",
+	"email":                "shane@exampleq3.com",
+	"phone": map[string]any{
+		"type":  nil,
+		"value": "(123) 456-7891",
+	},
+	"company":         "Exampleq3",
+	"links":           []any{"indeed.com/r/Shane-Smith/0b6c0b3e5e4b0d65"},
+	"comments":        nil,
+	"customQuestions": []any{},
+	"archived":        nil,
+}
+
+var applicationIDToApplication = map[string]map[string]any{
+	"cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa": applicationSeniorEngineer,
+}
+
+var postingIDToPosting = map[string]map[string]any{
+	"f2f01e16-27f8-4711-a728-7d49499795a0": postingCustomerSuccess,
+}
+
+// expandApplications expands the application IDs into application data, expanding the specified
+// fields in each application.
+func expandApplications(applicationIDs []string, fields ...string) []map[string]any {
+	applications := make([]map[string]any, 0, len(applicationIDs))
+	for _, applicationID := range applicationIDs {
+		application, ok := applicationIDToApplication[applicationID]
+		if !ok {
+			panic(fmt.Sprintf("No application found for ID %s", applicationID))
+		}
+
+		applications = append(applications, expandApplication(application, fields...))
+	}
+
+	return applications
+}
+
+// expandApplication expands the specified fields in the application data.
+func expandApplication(orig map[string]any, fields ...string) map[string]any {
+	expanded := make(map[string]any)
+	for k, v := range orig {
+		expanded[k] = v
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "posting":
+			posting, ok := postingIDToPosting[expanded["posting"].(string)]
+			if !ok {
+				panic(fmt.Sprintf("No posting found for ID %s", expanded["posting"]))
+			}
+
+			expanded["posting"] = posting
+
+		case "postingOwner", "postingHiringManager", "user":
+			expanded[field] = expandUser(expanded[field].(string))
+		}
+	}
+
+	return expanded
 }
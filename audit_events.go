@@ -0,0 +1,109 @@
+package lever
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever audit events client interface
+type AuditEventsClientInterface interface {
+	ClientInterface
+
+	// List all audit events
+	//
+	// Lists administrative activity in your Lever account, most recent first.
+	ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+}
+
+// Parameters for listing audit events.
+type ListAuditEventsRequest struct {
+	BaseListRequest
+
+	// Filter audit events by event type (e.g. user.created). See the model.AuditEventType
+	// constants.
+	Types []string
+
+	// Filter audit events by the id of the user who performed the action.
+	UserIDs []string
+
+	// If set, filter audit events by the timestamp they occurred at. If only CreatedAtStart is
+	// specified, all events from that timestamp (inclusive) to the present will be included. If
+	// only CreatedAtEnd is specified, all events before that timestamp (inclusive) are included.
+	CreatedAtStart *int64
+	CreatedAtEnd   *int64
+}
+
+// Create a new ListAuditEventsRequest with the required fields.
+func NewListAuditEventsRequest() *ListAuditEventsRequest {
+	return &ListAuditEventsRequest{}
+}
+
+func (r *ListAuditEventsRequest) GetPath() string {
+	return "audit_events"
+}
+
+func (r *ListAuditEventsRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseListRequest.AddAPIQueryParams(query)
+
+	for _, eventType := range r.Types {
+		query.Add(paramType, eventType)
+	}
+
+	for _, userID := range r.UserIDs {
+		query.Add(paramUserID, userID)
+	}
+
+	if r.CreatedAtStart != nil {
+		query.Add(paramCreatedAtStart, fmt.Sprint(*r.CreatedAtStart))
+	}
+
+	if r.CreatedAtEnd != nil {
+		query.Add(paramCreatedAtEnd, fmt.Sprint(*r.CreatedAtEnd))
+	}
+}
+
+// Response for listing audit events; returned to client users.
+type ListAuditEventsResponse struct {
+	BaseListResponse
+
+	// The audit event records.
+	AuditEvents []model.AuditEvent `json:"data"`
+}
+
+// JSON response type for listing audit events, with the metadata dynamically typed.
+type listAuditEventsResponseJSON struct {
+	BaseListResponse
+
+	// The audit event records.
+	AuditEvents []multimodel.AuditEvent `json:"data"`
+}
+
+// List all audit events
+//
+// Lists administrative activity in your Lever account, most recent first.
+func (c *Client) ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	var respJSON listAuditEventsResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	auditEvents := make([]model.AuditEvent, len(respJSON.AuditEvents))
+	for i := range respJSON.AuditEvents {
+		err := respJSON.AuditEvents[i].ToModel(&auditEvents[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListAuditEventsResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		AuditEvents:      auditEvents,
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,153 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEvents(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{auditEventAccessRoleUpdated, auditEventAPICredentialCreated}, "hasNext": true, "next": "audit-page-2"}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/audit_events"),
+			testclient.ExpectQuery("type", "user.access_role.updated"),
+			testclient.ExpectQuery("type", "api_credential.created"),
+			testclient.ExpectQuery("user_id", "df0adaa6-172c-4cd6-8520-49b203660fe1"),
+			testclient.ExpectQuery("created_at_start", "1696118400000"),
+			testclient.ExpectQuery("created_at_end", "1698796799999"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": []map[string]any{auditEventUnknown}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/audit_events"),
+			testclient.ExpectQuery("offset", "audit-page-2"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List audit events with filters
+	createdAtStart := int64(1696118400000)
+	createdAtEnd := int64(1698796799999)
+	listReq := NewListAuditEventsRequest()
+	listReq.Types = []string{model.AuditEventTypeUserAccessRoleUpdated, model.AuditEventTypeAPICredentialCreated}
+	listReq.UserIDs = []string{"df0adaa6-172c-4cd6-8520-49b203660fe1"}
+	listReq.CreatedAtStart = &createdAtStart
+	listReq.CreatedAtEnd = &createdAtEnd
+	listResp, err := c.ListAuditEvents(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.AuditEvents, 2) {
+		ta.True(listResp.HasNext)
+
+		event := listResp.AuditEvents[0]
+		ta.Equal("3f1c2b4a-5d6e-4f70-8a9b-0c1d2e3f4a5b", event.ID)
+		ta.Equal(model.AuditEventTypeUserAccessRoleUpdated, event.Type)
+		if ta.NotNil(event.Actor) {
+			ta.Equal("Chandler Bing", event.Actor.Name)
+		}
+		if ta.NotNil(event.Target) {
+			ta.Equal("user", event.Target.Type)
+			ta.Equal("Rachel Green", event.Target.Label)
+		}
+		if metadata, ok := event.Metadata.(*model.AuditEventUserAccessRoleMetadata); ta.True(ok) {
+			ta.Equal("interviewer", metadata.PreviousAccessRole)
+			ta.Equal("admin", metadata.AccessRole)
+		}
+		ta.NotEmpty(event.RawMetadata)
+
+		event = listResp.AuditEvents[1]
+		if metadata, ok := event.Metadata.(*model.AuditEventAPICredentialMetadata); ta.True(ok) {
+			ta.Equal("HRIS sync", metadata.Name)
+			ta.Equal([]string{"opportunities:read:admin", "requisitions:write:admin"}, metadata.Permissions)
+		}
+	}
+
+	// Events of unknown types keep their raw metadata
+	listReq = NewListAuditEventsRequest()
+	listReq.Offset = listResp.Next
+	listResp, err = c.ListAuditEvents(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.AuditEvents, 1) {
+		event := listResp.AuditEvents[0]
+		ta.Equal("posting.published", event.Type)
+		ta.Nil(event.Metadata)
+		ta.JSONEq(`{"postingId":"f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d","visibility":"public"}`, string(event.RawMetadata))
+	}
+
+	ta.Empty(s.Expected)
+}
+
+var auditEventAccessRoleUpdated = map[string]any{
+	"id":        "3f1c2b4a-5d6e-4f70-8a9b-0c1d2e3f4a5b",
+	"type":      "user.access_role.updated",
+	"createdAt": 1697500000000,
+	"user": map[string]any{
+		"id":    "df0adaa6-172c-4cd6-8520-49b203660fe1",
+		"name":  "Chandler Bing",
+		"email": "chandler@example.com",
+	},
+	"target": map[string]any{
+		"id":    "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+		"type":  "user",
+		"label": "Rachel Green",
+	},
+	"metadata": map[string]any{
+		"previousAccessRole": "interviewer",
+		"accessRole":         "admin",
+	},
+}
+
+var auditEventAPICredentialCreated = map[string]any{
+	"id":        "4a2d3c5b-6e7f-4081-9bac-1d2e3f4a5b6c",
+	"type":      "api_credential.created",
+	"createdAt": 1697400000000,
+	"user": map[string]any{
+		"id":    "df0adaa6-172c-4cd6-8520-49b203660fe1",
+		"name":  "Chandler Bing",
+		"email": "chandler@example.com",
+	},
+	"target": map[string]any{
+		"id":    "5b3e4d6c-7f80-4192-acbd-2e3f4a5b6c7d",
+		"type":  "api_credential",
+		"label": "HRIS sync",
+	},
+	"metadata": map[string]any{
+		"name":        "HRIS sync",
+		"permissions": []string{"opportunities:read:admin", "requisitions:write:admin"},
+	},
+}
+
+var auditEventUnknown = map[string]any{
+	"id":        "b6a8f0a2-1c3d-4e5f-8a7b-9c0d1e2f3a4b",
+	"type":      "posting.published",
+	"createdAt": 1697300000000,
+	"user": map[string]any{
+		"id":    "022d6639-1333-419b-9635-31f93015335f",
+		"name":  "Monica Geller",
+		"email": "monica@example.com",
+	},
+	"target": map[string]any{
+		"id":    "f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d",
+		"type":  "posting",
+		"label": "Senior Software Engineer",
+	},
+	"metadata": map[string]any{
+		"postingId":  "f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d",
+		"visibility": "public",
+	},
+}
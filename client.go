@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 
@@ -152,7 +153,8 @@ func (c *Client) exec(ctx context.Context, req RequestInterface, resp ResponseIn
 	decoder := json.NewDecoder(httpResp.Body)
 
 	if httpResp.StatusCode >= 200 && httpResp.StatusCode < 300 {
-		if err := decoder.Decode(resp); err != nil {
+		// Some endpoints (e.g. deletes) return an empty body on success.
+		if err := decoder.Decode(resp); err != nil && err != io.EOF {
 			return err
 		}
 
@@ -170,3 +172,32 @@ func (c *Client) exec(ctx context.Context, req RequestInterface, resp ResponseIn
 
 	return leverError
 }
+
+// Download a file.
+//
+// This calls [Client.send] to send the request. On success, the HTTP response is returned with
+// its body unread; the caller is responsible for closing it. Otherwise, the response body is
+// decoded into a [model.LeverError].
+func (c *Client) download(ctx context.Context, req RequestInterface) (*http.Response, error) {
+	httpResp, err := c.send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpResp.StatusCode >= 300 {
+		defer httpResp.Body.Close()
+		decoder := json.NewDecoder(httpResp.Body)
+		leverError := &model.LeverError{
+			HTTPResponse: httpResp,
+		}
+
+		if decoder.Decode(leverError) != nil {
+			leverError.Code = httpResp.Status
+			leverError.Message = fmt.Sprintf("Unexpected HTTP response status code: %d", httpResp.StatusCode)
+		}
+
+		return nil, leverError
+	}
+
+	return httpResp, nil
+}
@@ -57,6 +57,9 @@ const paramArchiveReasonID = "archive_reason_id"
 // Parameter key: cleanInterviews
 const paramCleanInterviews = "cleanInterviews"
 
+// Parameter key: commitment
+const paramCommitment = "commitment"
+
 // Parameter key: confidentiality
 const paramConfidentiality = "confidentiality"
 
@@ -75,6 +78,12 @@ const paramDeletedAtEnd = "deleted_at_end"
 // Parameter key: deleted_at_start
 const paramDeletedAtStart = "deleted_at_start"
 
+// Parameter key: department
+const paramDepartment = "department"
+
+// Parameter key: distribution_channel
+const paramDistributionChannel = "distribution_channel"
+
 // Parameter key: email
 const paramEmail = "email"
 
@@ -84,12 +93,21 @@ const paramExpand = "expand"
 // Parameter key: externalDirectoryId
 const paramExternalDirectoryId = "external_directory_id"
 
+// Parameter key: fromDate
+const paramFromDate = "fromDate"
+
 // Parameter key: include
 const paramInclude = "include"
 
 // Parameter key: includeDeactivated
 const paramIncludeDeactivated = "includeDeactivated"
 
+// Parameter key: level
+const paramLevel = "level"
+
+// Parameter key: note_id
+const paramNoteID = "note_id"
+
 // Parameter key: offset
 const paramOffset = "offset"
 
@@ -120,9 +138,15 @@ const paramPostingID = "posting_id"
 // Parameter key: reason
 const paramReason = "reason"
 
+// Parameter key: requisition_code
+const paramRequisitionCode = "requisition_code"
+
 // Parameter key: requisitionId
 const paramRequisitionID = "requisitionId"
 
+// Parameter key: send_confirmation_email
+const paramSendConfirmationEmail = "send_confirmation_email"
+
 // Parameter key: source
 const paramSource = "source"
 
@@ -138,14 +162,38 @@ const paramStage = "stage"
 // Parameter key: stage_id
 const paramStageID = "stage_id"
 
+// Parameter key: state
+const paramState = "state"
+
+// Parameter key: status
+const paramStatus = "status"
+
 // Parameter key: tag
 const paramTag = "tag"
 
 // Parameter key: tags
 const paramTags = "tags"
 
+// Parameter key: team
+const paramTeam = "team"
+
+// Parameter key: toDate
+const paramToDate = "toDate"
+
+// Parameter key: type
+const paramType = "type"
+
 // Parameter key: updated_at_end
 const paramUpdatedAtEnd = "updated_at_end"
 
 // Parameter key: updated_at_start
 const paramUpdatedAtStart = "updated_at_start"
+
+// Parameter key: uploadedAtEnd
+const paramUploadedAtEnd = "uploadedAtEnd"
+
+// Parameter key: uploadedAtStart
+const paramUploadedAtStart = "uploadedAtStart"
+
+// Parameter key: user_id
+const paramUserID = "user_id"
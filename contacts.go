@@ -1,9 +1,7 @@
 package lever
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -69,7 +67,7 @@ func (r *UpdateContactRequest) GetPath() string {
 	return fmt.Sprintf("contacts/%s", url.PathEscape(r.Contact.ID))
 }
 
-func (r *UpdateContactRequest) GetMethod() string {
+func (r *UpdateContactRequest) GetHTTPMethod() string {
 	return http.MethodPut
 }
 
@@ -77,16 +75,7 @@ func (r *UpdateContactRequest) GetBody() (io.Reader, error) {
 	contactClone := *r.Contact
 	contactClone.ID = ""
 
-	result := bytes.Buffer{}
-	encoder := json.NewEncoder(&result)
-	encoder.SetEscapeHTML(false)
-	encoder.SetIndent("", "")
-	if err := encoder.Encode(&contactClone); err != nil {
-		return nil, err
-	}
-
-	json.Marshal(&contactClone)
-	return &result, nil
+	return encodeJSONBody(&contactClone)
 }
 
 // Response for updating a contact.
@@ -96,3 +85,23 @@ type UpdateContactResponse struct {
 	// The contact record.
 	Contact *model.Contact `json:"data"`
 }
+
+// Retrieve a single contact.
+func (c *Client) GetContact(ctx context.Context, req *GetContactRequest) (*GetContactResponse, error) {
+	var resp GetContactResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update a contact.
+func (c *Client) UpdateContact(ctx context.Context, req *UpdateContactRequest) (*UpdateContactResponse, error) {
+	var resp UpdateContactResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
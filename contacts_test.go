@@ -0,0 +1,98 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContacts(t *testing.T) {
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			`{"data":{"id":"7f23e772-f2cb-4ebb-b33f-54b872999992","name":"Shane Smith","headline":"Brickly LLC, Vandelay Industries, Inc, Central Perk","isAnonymized":false,"location":{"name":"Oakland"},"emails":["shane@exampleq3.com"],"phones":[{"type":"mobile","value":"(123) 456-7891"}]}}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/contacts/7f23e772-f2cb-4ebb-b33f-54b872999992"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			`{"data":{"id":"7f23e772-f2cb-4ebb-b33f-54b872999992","name":"Shane Smith","headline":"Brickly LLC, Vandelay Industries, Inc, Central Perk","isAnonymized":false,"location":{"name":"San Francisco"},"emails":["shane@exampleq3.com","shane@example.com"],"phones":[{"type":"mobile","value":"(123) 456-7891"},{"type":"work","value":"(234) 567-8901"}]}}`,
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath("/v1/contacts/7f23e772-f2cb-4ebb-b33f-54b872999992"),
+			testclient.ExpectHeader("Content-Type", "application/json"),
+			testclient.ExpectBody(`{"name":"Shane Smith","headline":"Brickly LLC, Vandelay Industries, Inc, Central Perk","location":{"name":"San Francisco"},"emails":["shane@exampleq3.com","shane@example.com"],"phones":[{"type":"mobile","value":"(123) 456-7891"},{"type":"work","value":"(234) 567-8901"}]}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Contact was not found"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/contacts/00000000-0000-0000-0000-000000000000"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	ta := assert.New(t)
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// Get a contact.
+	getReq := NewGetContactRequest("7f23e772-f2cb-4ebb-b33f-54b872999992")
+	getResp, err := c.GetContact(ctx, getReq)
+
+	if ta.NoError(err) && ta.NotNil(getResp.Contact) {
+		ta.Equal("Shane Smith", getResp.Contact.Name)
+		if ta.NotNil(getResp.Contact.Location) {
+			ta.Equal("Oakland", getResp.Contact.Location.Name)
+		}
+		ta.Equal([]string{"shane@exampleq3.com"}, getResp.Contact.Emails)
+		if ta.Len(getResp.Contact.Phones, 1) {
+			ta.Equal("mobile", getResp.Contact.Phones[0].Type)
+		}
+	} else {
+		t.Fatal("Failed to get contact; cannot proceed with remaining tests")
+	}
+
+	// Update the contact's location, emails and phones.
+	contact := *getResp.Contact
+	contact.Location = &model.ContactLocation{Name: "San Francisco"}
+	contact.Emails = append(contact.Emails, "shane@example.com")
+	contact.Phones = append(contact.Phones, model.Phone{Type: "work", Value: "(234) 567-8901"})
+
+	updateReq := NewUpdateContactRequest(&contact)
+	updateResp, err := c.UpdateContact(ctx, updateReq)
+
+	if ta.NoError(err) && ta.NotNil(updateResp.Contact) {
+		ta.Equal("7f23e772-f2cb-4ebb-b33f-54b872999992", updateResp.Contact.ID)
+		if ta.NotNil(updateResp.Contact.Location) {
+			ta.Equal("San Francisco", updateResp.Contact.Location.Name)
+		}
+		ta.Len(updateResp.Contact.Emails, 2)
+		ta.Len(updateResp.Contact.Phones, 2)
+	}
+
+	// The request must not modify the caller's contact.
+	ta.Equal("7f23e772-f2cb-4ebb-b33f-54b872999992", contact.ID)
+
+	// Get a contact that does not exist.
+	getReq = NewGetContactRequest("00000000-0000-0000-0000-000000000000")
+	getResp, err = c.GetContact(ctx, getReq)
+
+	if ta.Error(err) {
+		ta.Nil(getResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+			if ta.NotNil(leverError.HTTPResponse) {
+				ta.Equal(http.StatusNotFound, leverError.HTTPResponse.StatusCode)
+			}
+		}
+	}
+}
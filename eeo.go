@@ -0,0 +1,128 @@
+package lever
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever EEO client interface
+type EEOClientInterface interface {
+	ClientInterface
+
+	// List de-identified EEO responses
+	//
+	// Lists the EEO responses submitted with job applications, without information that can be
+	// used to identify the candidate.
+	ListEEOResponses(ctx context.Context, req *ListEEOResponsesRequest) (*ListEEOResponsesResponse, error)
+
+	// List EEO responses with PII
+	//
+	// Lists the EEO responses submitted with job applications, linked to the candidate's
+	// opportunity. This requires the EEO PII scope.
+	ListEEOResponsesPII(ctx context.Context, req *ListEEOResponsesPIIRequest) (*ListEEOResponsesPIIResponse, error)
+}
+
+// Date range filters common to the EEO list requests.
+type EEOResponsesFilter struct {
+	// If set, only include responses submitted at or after this timestamp.
+	FromDate *int64
+
+	// If set, only include responses submitted at or before this timestamp.
+	ToDate *int64
+}
+
+// Add the date range filters to the query.
+func (f *EEOResponsesFilter) addQueryParams(query *url.Values) {
+	if f.FromDate != nil {
+		query.Add(paramFromDate, fmt.Sprint(*f.FromDate))
+	}
+
+	if f.ToDate != nil {
+		query.Add(paramToDate, fmt.Sprint(*f.ToDate))
+	}
+}
+
+// Parameters for listing de-identified EEO responses.
+type ListEEOResponsesRequest struct {
+	BaseListRequest
+	EEOResponsesFilter
+}
+
+// Create a new ListEEOResponsesRequest with the required fields.
+func NewListEEOResponsesRequest() *ListEEOResponsesRequest {
+	return &ListEEOResponsesRequest{}
+}
+
+func (r *ListEEOResponsesRequest) GetPath() string {
+	return "eeo/responses"
+}
+
+func (r *ListEEOResponsesRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseListRequest.AddAPIQueryParams(query)
+	r.EEOResponsesFilter.addQueryParams(query)
+}
+
+// Response for listing de-identified EEO responses.
+type ListEEOResponsesResponse struct {
+	BaseListResponse
+
+	// The EEO response records.
+	EEOResponses []model.EEOResponse `json:"data"`
+}
+
+// Parameters for listing EEO responses with PII.
+type ListEEOResponsesPIIRequest struct {
+	BaseListRequest
+	EEOResponsesFilter
+}
+
+// Create a new ListEEOResponsesPIIRequest with the required fields.
+func NewListEEOResponsesPIIRequest() *ListEEOResponsesPIIRequest {
+	return &ListEEOResponsesPIIRequest{}
+}
+
+func (r *ListEEOResponsesPIIRequest) GetPath() string {
+	return "eeo/responses/pii"
+}
+
+func (r *ListEEOResponsesPIIRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseListRequest.AddAPIQueryParams(query)
+	r.EEOResponsesFilter.addQueryParams(query)
+}
+
+// Response for listing EEO responses with PII.
+type ListEEOResponsesPIIResponse struct {
+	BaseListResponse
+
+	// The EEO response records.
+	EEOResponses []model.EEOResponsePII `json:"data"`
+}
+
+// List de-identified EEO responses
+//
+// Lists the EEO responses submitted with job applications, without information that can be used
+// to identify the candidate.
+func (c *Client) ListEEOResponses(ctx context.Context, req *ListEEOResponsesRequest) (*ListEEOResponsesResponse, error) {
+	var resp ListEEOResponsesResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// List EEO responses with PII
+//
+// Lists the EEO responses submitted with job applications, linked to the candidate's opportunity.
+// This requires the EEO PII scope.
+func (c *Client) ListEEOResponsesPII(ctx context.Context, req *ListEEOResponsesPIIRequest) (*ListEEOResponsesPIIResponse, error) {
+	var resp ListEEOResponsesPIIResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
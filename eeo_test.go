@@ -0,0 +1,212 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEEOResponses(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{eeoResponseDeclined}, "hasNext": true, "next": "eeo-page-2"}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/eeo/responses"),
+			testclient.ExpectQuery("fromDate", "1672531200000"),
+			testclient.ExpectQuery("toDate", "1704067199999"),
+			testclient.ExpectQuery("limit", "1"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": []map[string]any{eeoResponseComplete}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/eeo/responses"),
+			testclient.ExpectQuery("offset", "eeo-page-2"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List the first page
+	fromDate := int64(1672531200000)
+	toDate := int64(1704067199999)
+	listReq := NewListEEOResponsesRequest()
+	listReq.FromDate = &fromDate
+	listReq.ToDate = &toDate
+	listReq.Limit = 1
+	listResp, err := c.ListEEOResponses(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.EEOResponses, 1) {
+		ta.True(listResp.HasNext)
+		response := listResp.EEOResponses[0]
+		ta.Equal(model.EEOGenderDeclineToSelfIdentify, response.Responses.Gender)
+		ta.Equal(model.EEODisabilityDeclineToAnswer, response.Responses.Disability)
+		ta.Empty(response.Responses.DisabilitySignature)
+		ta.Equal("f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d", response.PostingID)
+	}
+
+	// List the next page
+	listReq.Offset = listResp.Next
+	listResp, err = c.ListEEOResponses(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.EEOResponses, 1) {
+		ta.False(listResp.HasNext)
+		responses := listResp.EEOResponses[0].Responses
+		ta.Equal(model.EEOGenderFemale, responses.Gender)
+		ta.Equal(model.EEORaceAsian, responses.Race)
+		ta.Equal(model.EEOVeteranNotProtected, responses.Veteran)
+		ta.Equal(model.EEODisabilityNo, responses.Disability)
+		ta.Equal("Monica Geller", responses.DisabilitySignature)
+		ta.Equal("05/15/2023", responses.DisabilitySignatureDate)
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestApplyToPostingWithEEOResponses(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			`{"data":{"applicationId":"cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"}}`,
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/postings/f2f01e16-27f8-4711-a728-7d49499795a0/apply"),
+			testclient.ExpectFormValue("eeoResponses[gender]", model.EEOGenderFemale),
+			testclient.ExpectFormValue("eeoResponses[race]", model.EEORaceAsian),
+			testclient.ExpectFormValue("eeoResponses[veteran]", model.EEOVeteranNotProtected),
+			testclient.ExpectFormValue("eeoResponses[disability]", model.EEODisabilityNo),
+			testclient.ExpectFormValue("eeoResponses[disabilitySignature]", "Monica Geller"),
+			testclient.ExpectFormValue("eeoResponses[disabilitySignatureDate]", "05/15/2023"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// The EEO responses type returned by the EEO API is also used for the answers when applying.
+	applyReq := NewApplyToPostingRequest("f2f01e16-27f8-4711-a728-7d49499795a0")
+	applyReq.EEOResponses = &model.EEOResponses{
+		Gender:                  model.EEOGenderFemale,
+		Race:                    model.EEORaceAsian,
+		Veteran:                 model.EEOVeteranNotProtected,
+		Disability:              model.EEODisabilityNo,
+		DisabilitySignature:     "Monica Geller",
+		DisabilitySignatureDate: "05/15/2023",
+	}
+
+	applyResp, err := c.ApplyToPosting(ctx, applyReq)
+	if ta.NoError(err) && ta.NotNil(applyResp.Result) {
+		ta.Equal("cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa", applyResp.Result.ApplicationID)
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestEEOResponsesPII(t *testing.T) {
+	ta := assert.New(t)
+
+	piiResponse := map[string]any{
+		"opportunityId": "250d8f03-738a-4bba-a671-8a3d73477145",
+		"contactId":     "7f23e772-f2cf-4ebb-9bc3-0cb8b0c3c5fa",
+		"name":          "Monica Geller",
+		"emails":        []string{"monica@example.com"},
+	}
+	for k, v := range eeoResponseComplete {
+		piiResponse[k] = v
+	}
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{piiResponse}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/eeo/responses/pii"),
+			testclient.ExpectQuery("fromDate", "1672531200000"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusForbidden,
+			`{"code":"Forbidden","message":"API key does not have the required scope"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/eeo/responses/pii"),
+			testclient.ExpectNoQuery(),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List responses with PII
+	fromDate := int64(1672531200000)
+	listReq := NewListEEOResponsesPIIRequest()
+	listReq.FromDate = &fromDate
+	listResp, err := c.ListEEOResponsesPII(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.EEOResponses, 1) {
+		response := listResp.EEOResponses[0]
+		ta.Equal("250d8f03-738a-4bba-a671-8a3d73477145", response.OpportunityID)
+		ta.Equal("Monica Geller", response.Name)
+		ta.Equal([]string{"monica@example.com"}, response.Emails)
+		ta.Equal(model.EEORaceAsian, response.Responses.Race)
+		ta.Equal("f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d", response.PostingID)
+	}
+
+	// Without the PII scope
+	listResp, err = c.ListEEOResponsesPII(ctx, NewListEEOResponsesPIIRequest())
+
+	if ta.Error(err) {
+		ta.Nil(listResp)
+		var leverError *model.LeverError
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("Forbidden", leverError.Code)
+		}
+	}
+
+	ta.Empty(s.Expected)
+}
+
+var eeoResponseDeclined = map[string]any{
+	"eeoResponses": map[string]any{
+		"gender":     "Decline to self-identify",
+		"race":       "Decline to self-identify",
+		"veteran":    "Decline to self-identify",
+		"disability": "I don't wish to answer",
+	},
+	"createdAt":    1675209600000,
+	"posting":      "f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d",
+	"currentStage": "00922a60-7c15-422b-b086-f62000824fd7",
+}
+
+var eeoResponseComplete = map[string]any{
+	"eeoResponses": map[string]any{
+		"gender":                  "Female",
+		"race":                    "Asian (Not Hispanic or Latino)",
+		"veteran":                 "I am not a Protected Veteran",
+		"disability":              "No, I don't have a disability, or a history/record of having a disability",
+		"disabilitySignature":     "Monica Geller",
+		"disabilitySignatureDate": "05/15/2023",
+	},
+	"createdAt":    1684108800000,
+	"posting":      "f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d",
+	"currentStage": "00922a60-7c15-422b-b086-f62000824fd7",
+}
@@ -0,0 +1,439 @@
+package lever
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever feedback forms client interface
+type FeedbackClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single feedback form
+	//
+	// This method returns the full feedback record for a single feedback form on an opportunity.
+	GetFeedbackForm(ctx context.Context, req *GetFeedbackFormRequest) (*GetFeedbackFormResponse, error)
+
+	// List all feedback forms
+	//
+	// Lists all feedback forms for an opportunity.
+	ListFeedbackForms(ctx context.Context, req *ListFeedbackFormsRequest) (*ListFeedbackFormsResponse, error)
+
+	// Create a feedback form
+	//
+	// Creates a feedback form on an opportunity from a feedback template, optionally associated
+	// with an interview panel and interview.
+	CreateFeedbackForm(ctx context.Context, req *CreateFeedbackFormRequest) (*CreateFeedbackFormResponse, error)
+
+	// Update a feedback form
+	//
+	// Updates the field values of a feedback form. Fields that are not specified are left
+	// unchanged.
+	UpdateFeedbackForm(ctx context.Context, req *UpdateFeedbackFormRequest) (*UpdateFeedbackFormResponse, error)
+
+	// Delete a feedback form
+	//
+	// Deletes a feedback form from an opportunity.
+	DeleteFeedbackForm(ctx context.Context, req *DeleteFeedbackFormRequest) (*DeleteFeedbackFormResponse, error)
+}
+
+// Parameters for retrieving a single feedback form.
+type GetFeedbackFormRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The feedback form id. This is required.
+	FeedbackFormID string
+}
+
+// Create a new GetFeedbackFormRequest with the required fields.
+func NewGetFeedbackFormRequest(opportunityID, feedbackFormID string) *GetFeedbackFormRequest {
+	return &GetFeedbackFormRequest{
+		OpportunityID:  opportunityID,
+		FeedbackFormID: feedbackFormID,
+	}
+}
+
+func (r *GetFeedbackFormRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/feedback/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.FeedbackFormID))
+}
+
+// Response for retrieving a single feedback form; returned to client users.
+type GetFeedbackFormResponse struct {
+	BaseResponse
+
+	// The feedback form record.
+	FeedbackForm *model.FeedbackForm `json:"data"`
+}
+
+// JSON response type for retrieving a single feedback form, with some field types dynamically
+// determined.
+type getFeedbackFormResponseJSON struct {
+	BaseResponse
+
+	// The feedback form record.
+	FeedbackForm *multimodel.FeedbackForm `json:"data"`
+}
+
+// Parameters for listing feedback forms.
+type ListFeedbackFormsRequest struct {
+	BaseListRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+}
+
+// Create a new ListFeedbackFormsRequest with the required fields.
+func NewListFeedbackFormsRequest(opportunityID string) *ListFeedbackFormsRequest {
+	return &ListFeedbackFormsRequest{
+		OpportunityID: opportunityID,
+	}
+}
+
+func (r *ListFeedbackFormsRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/feedback", url.PathEscape(r.OpportunityID))
+}
+
+// Response for listing feedback forms; returned to client users.
+type ListFeedbackFormsResponse struct {
+	BaseListResponse
+
+	// The feedback form records.
+	FeedbackForms []model.FeedbackForm `json:"data"`
+}
+
+// JSON response type for listing feedback forms, with some field types dynamically determined.
+type listFeedbackFormsResponseJSON struct {
+	BaseListResponse
+
+	// The feedback form records.
+	FeedbackForms []multimodel.FeedbackForm `json:"data"`
+}
+
+// JSON body for the feedback form create and update requests.
+type feedbackFormRequestBody struct {
+	BaseTemplateID string                     `json:"baseTemplateId,omitempty"`
+	PanelID        string                     `json:"panel,omitempty"`
+	InterviewID    string                     `json:"interview,omitempty"`
+	FieldValues    []model.FeedbackFieldValue `json:"fieldValues,omitempty"`
+	CreatedAt      *int64                     `json:"createdAt,omitempty"`
+	CompletedAt    *int64                     `json:"completedAt,omitempty"`
+}
+
+// Parameters for creating a feedback form.
+type CreateFeedbackFormRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// Perform this create on behalf of a specified user. The feedback will be attributed to this
+	// user. This is required.
+	PerformAsID string
+
+	// The feedback template id the form is based on. This is required.
+	BaseTemplateID string
+
+	// The interview panel id the feedback is for. This is optional.
+	PanelID string
+
+	// The interview id the feedback is for. If specified, PanelID must also be specified. This is
+	// optional.
+	InterviewID string
+
+	// The values of the fields in the feedback form. This is optional.
+	FieldValues []model.FeedbackFieldValue
+
+	// Datetime when the feedback was created. Defaults to the current time.
+	CreatedAt *int64
+
+	// Datetime when the feedback was completed. Defaults to the current time.
+	CompletedAt *int64
+}
+
+// Create a new CreateFeedbackFormRequest with the required fields.
+func NewCreateFeedbackFormRequest(performAsID, opportunityID, baseTemplateID string) *CreateFeedbackFormRequest {
+	return &CreateFeedbackFormRequest{
+		OpportunityID:  opportunityID,
+		PerformAsID:    performAsID,
+		BaseTemplateID: baseTemplateID,
+	}
+}
+
+func (r *CreateFeedbackFormRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/feedback", url.PathEscape(r.OpportunityID))
+}
+
+func (r *CreateFeedbackFormRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreateFeedbackFormRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *CreateFeedbackFormRequest) GetBody() (io.Reader, error) {
+	body := feedbackFormRequestBody{
+		BaseTemplateID: r.BaseTemplateID,
+		PanelID:        r.PanelID,
+		InterviewID:    r.InterviewID,
+		FieldValues:    r.FieldValues,
+		CreatedAt:      r.CreatedAt,
+		CompletedAt:    r.CompletedAt,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Response for creating a feedback form; returned to client users.
+type CreateFeedbackFormResponse struct {
+	BaseResponse
+
+	// The feedback form record.
+	FeedbackForm *model.FeedbackForm `json:"data"`
+}
+
+// JSON response type for creating a feedback form, with some field types dynamically determined.
+type createFeedbackFormResponseJSON struct {
+	BaseResponse
+
+	// The feedback form record.
+	FeedbackForm *multimodel.FeedbackForm `json:"data"`
+}
+
+// Parameters for updating a feedback form.
+type UpdateFeedbackFormRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The feedback form id. This is required.
+	FeedbackFormID string
+
+	// Perform this update on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// The values of the fields to update. This is optional.
+	FieldValues []model.FeedbackFieldValue
+
+	// Datetime when the feedback was completed. This is optional.
+	CompletedAt *int64
+}
+
+// Create a new UpdateFeedbackFormRequest with the required fields.
+func NewUpdateFeedbackFormRequest(performAsID, opportunityID, feedbackFormID string) *UpdateFeedbackFormRequest {
+	return &UpdateFeedbackFormRequest{
+		OpportunityID:  opportunityID,
+		FeedbackFormID: feedbackFormID,
+		PerformAsID:    performAsID,
+	}
+}
+
+func (r *UpdateFeedbackFormRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/feedback/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.FeedbackFormID))
+}
+
+func (r *UpdateFeedbackFormRequest) GetHTTPMethod() string {
+	return http.MethodPut
+}
+
+func (r *UpdateFeedbackFormRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *UpdateFeedbackFormRequest) GetBody() (io.Reader, error) {
+	body := feedbackFormRequestBody{
+		FieldValues: r.FieldValues,
+		CompletedAt: r.CompletedAt,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Response for updating a feedback form; returned to client users.
+type UpdateFeedbackFormResponse struct {
+	BaseResponse
+
+	// The feedback form record.
+	FeedbackForm *model.FeedbackForm `json:"data"`
+}
+
+// JSON response type for updating a feedback form, with some field types dynamically determined.
+type updateFeedbackFormResponseJSON struct {
+	BaseResponse
+
+	// The feedback form record.
+	FeedbackForm *multimodel.FeedbackForm `json:"data"`
+}
+
+// Parameters for deleting a feedback form.
+type DeleteFeedbackFormRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The feedback form id. This is required.
+	FeedbackFormID string
+
+	// Perform this delete on behalf of a specified user. This is required.
+	PerformAsID string
+}
+
+// Create a new DeleteFeedbackFormRequest with the required fields.
+func NewDeleteFeedbackFormRequest(performAsID, opportunityID, feedbackFormID string) *DeleteFeedbackFormRequest {
+	return &DeleteFeedbackFormRequest{
+		OpportunityID:  opportunityID,
+		FeedbackFormID: feedbackFormID,
+		PerformAsID:    performAsID,
+	}
+}
+
+func (r *DeleteFeedbackFormRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/feedback/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.FeedbackFormID))
+}
+
+func (r *DeleteFeedbackFormRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+func (r *DeleteFeedbackFormRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+// Response for deleting a feedback form.
+type DeleteFeedbackFormResponse struct {
+	BaseResponse
+}
+
+// Retrieve a single feedback form
+//
+// This method returns the full feedback record for a single feedback form on an opportunity.
+func (c *Client) GetFeedbackForm(ctx context.Context, req *GetFeedbackFormRequest) (*GetFeedbackFormResponse, error) {
+	var respJSON getFeedbackFormResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var feedbackForm model.FeedbackForm
+	err := respJSON.FeedbackForm.ToModel(&feedbackForm)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetFeedbackFormResponse{
+		BaseResponse: respJSON.BaseResponse,
+		FeedbackForm: &feedbackForm,
+	}
+
+	return &resp, nil
+}
+
+// List all feedback forms
+//
+// Lists all feedback forms for an opportunity.
+func (c *Client) ListFeedbackForms(ctx context.Context, req *ListFeedbackFormsRequest) (*ListFeedbackFormsResponse, error) {
+	var respJSON listFeedbackFormsResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	feedbackForms := make([]model.FeedbackForm, len(respJSON.FeedbackForms))
+	for i := range respJSON.FeedbackForms {
+		err := respJSON.FeedbackForms[i].ToModel(&feedbackForms[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListFeedbackFormsResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		FeedbackForms:    feedbackForms,
+	}
+
+	return &resp, nil
+}
+
+// Create a feedback form
+//
+// Creates a feedback form on an opportunity from a feedback template, optionally associated with
+// an interview panel and interview.
+func (c *Client) CreateFeedbackForm(ctx context.Context, req *CreateFeedbackFormRequest) (*CreateFeedbackFormResponse, error) {
+	var respJSON createFeedbackFormResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var feedbackForm model.FeedbackForm
+	err := respJSON.FeedbackForm.ToModel(&feedbackForm)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := CreateFeedbackFormResponse{
+		BaseResponse: respJSON.BaseResponse,
+		FeedbackForm: &feedbackForm,
+	}
+
+	return &resp, nil
+}
+
+// Update a feedback form
+//
+// Updates the field values of a feedback form. Fields that are not specified are left unchanged.
+func (c *Client) UpdateFeedbackForm(ctx context.Context, req *UpdateFeedbackFormRequest) (*UpdateFeedbackFormResponse, error) {
+	var respJSON updateFeedbackFormResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var feedbackForm model.FeedbackForm
+	err := respJSON.FeedbackForm.ToModel(&feedbackForm)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := UpdateFeedbackFormResponse{
+		BaseResponse: respJSON.BaseResponse,
+		FeedbackForm: &feedbackForm,
+	}
+
+	return &resp, nil
+}
+
+// Delete a feedback form
+//
+// Deletes a feedback form from an opportunity.
+func (c *Client) DeleteFeedbackForm(ctx context.Context, req *DeleteFeedbackFormRequest) (*DeleteFeedbackFormResponse, error) {
+	var resp DeleteFeedbackFormResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,358 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever feedback templates client interface
+type FeedbackTemplatesClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single feedback template
+	GetFeedbackTemplate(ctx context.Context, req *GetFeedbackTemplateRequest) (*GetFeedbackTemplateResponse, error)
+
+	// List all feedback templates
+	//
+	// Lists all active feedback templates in your Lever account.
+	ListFeedbackTemplates(ctx context.Context, req *ListFeedbackTemplatesRequest) (*ListFeedbackTemplatesResponse, error)
+
+	// Create a feedback template
+	//
+	// Templates created via the API cannot be edited in the Lever application.
+	CreateFeedbackTemplate(ctx context.Context, req *CreateFeedbackTemplateRequest) (*CreateFeedbackTemplateResponse, error)
+
+	// Update a feedback template
+	//
+	// This replaces the template; all fields must be specified. Only templates created via the API
+	// can be updated.
+	UpdateFeedbackTemplate(ctx context.Context, req *UpdateFeedbackTemplateRequest) (*UpdateFeedbackTemplateResponse, error)
+
+	// Delete a feedback template
+	//
+	// Only templates created via the API can be deleted.
+	DeleteFeedbackTemplate(ctx context.Context, req *DeleteFeedbackTemplateRequest) (*DeleteFeedbackTemplateResponse, error)
+}
+
+// Parameters for retrieving a single feedback template.
+type GetFeedbackTemplateRequest struct {
+	BaseRequest
+
+	// The feedback template id. This is required.
+	FeedbackTemplateID string
+}
+
+// Create a new GetFeedbackTemplateRequest with the required fields.
+func NewGetFeedbackTemplateRequest(feedbackTemplateID string) *GetFeedbackTemplateRequest {
+	return &GetFeedbackTemplateRequest{
+		FeedbackTemplateID: feedbackTemplateID,
+	}
+}
+
+func (r *GetFeedbackTemplateRequest) GetPath() string {
+	return fmt.Sprintf("feedback_templates/%s", url.PathEscape(r.FeedbackTemplateID))
+}
+
+// Response for retrieving a single feedback template.
+type GetFeedbackTemplateResponse struct {
+	BaseResponse
+
+	// The feedback template record.
+	FeedbackTemplate *model.FeedbackTemplate `json:"data"`
+}
+
+// Parameters for listing feedback templates.
+type ListFeedbackTemplatesRequest struct {
+	BaseListRequest
+}
+
+// Create a new ListFeedbackTemplatesRequest with the required fields.
+func NewListFeedbackTemplatesRequest() *ListFeedbackTemplatesRequest {
+	return &ListFeedbackTemplatesRequest{}
+}
+
+func (r *ListFeedbackTemplatesRequest) GetPath() string {
+	return "feedback_templates"
+}
+
+// Response for listing feedback templates.
+type ListFeedbackTemplatesResponse struct {
+	BaseListResponse
+
+	// The feedback template records.
+	FeedbackTemplates []model.FeedbackTemplate `json:"data"`
+}
+
+// Fields common to feedback template create and update requests.
+type FeedbackTemplateFields struct {
+	// Template title. This is required.
+	Text string
+
+	// Template instructions.
+	Instructions string
+
+	// The group id the template belongs to.
+	GroupID string
+
+	// The fields in the template. At least one field is required. Each field must have a type and
+	// text; dropdown, multiple-choice and multiple-select fields must have options, and scorecard
+	// fields must have skills.
+	Fields []model.FormField
+}
+
+// Check that the required feedback template fields are present. All problems found are returned,
+// joined with [errors.Join].
+func (f *FeedbackTemplateFields) Validate() error {
+	var errs []error
+
+	if f.Text == "" {
+		errs = append(errs, errors.New("text is required"))
+	}
+
+	if len(f.Fields) == 0 {
+		errs = append(errs, errors.New("at least one field is required"))
+	}
+
+	errs = append(errs, validateTemplateFormFields(f.Fields)...)
+
+	return errors.Join(errs...)
+}
+
+// Validate the field definitions of a form template. Each field must have a type and text;
+// dropdown, multiple-choice and multiple-select fields must have options, and scorecard fields
+// must have skills.
+func validateTemplateFormFields(fields []model.FormField) []error {
+	var errs []error
+
+	for i, field := range fields {
+		if field.Type == "" {
+			errs = append(errs, fmt.Errorf("field %d: type is required", i))
+		}
+
+		if field.Text == "" {
+			errs = append(errs, fmt.Errorf("field %d: text is required", i))
+		}
+
+		switch field.Type {
+		case model.FormFieldTypeDropdown, model.FormFieldTypeMultipleChoice, model.FormFieldTypeMultipleSelect:
+			if len(field.Options) == 0 {
+				errs = append(errs, fmt.Errorf("field %d: %s field requires options", i, field.Type))
+			}
+
+		case model.FormFieldTypeScorecard:
+			if len(field.Skills) == 0 {
+				errs = append(errs, fmt.Errorf("field %d: scorecard field requires skills", i))
+			}
+		}
+	}
+
+	return errs
+}
+
+// JSON body for the feedback template create and update requests.
+type feedbackTemplateRequestBody struct {
+	Text         string            `json:"text"`
+	Instructions string            `json:"instructions,omitempty"`
+	GroupID      string            `json:"group,omitempty"`
+	Fields       []model.FormField `json:"fields"`
+}
+
+// Encode the feedback template fields as a JSON request body.
+func (f *FeedbackTemplateFields) encode() (io.Reader, error) {
+	body := feedbackTemplateRequestBody{
+		Text:         f.Text,
+		Instructions: f.Instructions,
+		GroupID:      f.GroupID,
+		Fields:       f.Fields,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Parameters for creating a feedback template.
+type CreateFeedbackTemplateRequest struct {
+	BaseRequest
+	FeedbackTemplateFields
+}
+
+// Create a new CreateFeedbackTemplateRequest with the required fields.
+func NewCreateFeedbackTemplateRequest(text string, fields []model.FormField) *CreateFeedbackTemplateRequest {
+	return &CreateFeedbackTemplateRequest{
+		FeedbackTemplateFields: FeedbackTemplateFields{
+			Text:   text,
+			Fields: fields,
+		},
+	}
+}
+
+func (r *CreateFeedbackTemplateRequest) GetPath() string {
+	return "feedback_templates"
+}
+
+func (r *CreateFeedbackTemplateRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreateFeedbackTemplateRequest) GetBody() (io.Reader, error) {
+	return r.FeedbackTemplateFields.encode()
+}
+
+// Response for creating a feedback template.
+type CreateFeedbackTemplateResponse struct {
+	BaseResponse
+
+	// The feedback template record.
+	FeedbackTemplate *model.FeedbackTemplate `json:"data"`
+}
+
+// Parameters for updating a feedback template.
+type UpdateFeedbackTemplateRequest struct {
+	BaseRequest
+	FeedbackTemplateFields
+
+	// The feedback template id. This is required.
+	FeedbackTemplateID string
+}
+
+// Create a new UpdateFeedbackTemplateRequest with the required fields.
+func NewUpdateFeedbackTemplateRequest(feedbackTemplateID, text string, fields []model.FormField) *UpdateFeedbackTemplateRequest {
+	return &UpdateFeedbackTemplateRequest{
+		FeedbackTemplateFields: FeedbackTemplateFields{
+			Text:   text,
+			Fields: fields,
+		},
+		FeedbackTemplateID: feedbackTemplateID,
+	}
+}
+
+// Create a new UpdateFeedbackTemplateRequest based on an existing FeedbackTemplate struct.
+func NewUpdateFeedbackTemplateRequestFromFeedbackTemplate(template *model.FeedbackTemplate) *UpdateFeedbackTemplateRequest {
+	req := NewUpdateFeedbackTemplateRequest(template.ID, template.Text, template.Fields)
+	req.Instructions = template.Instructions
+	if template.Group != nil {
+		req.GroupID = template.Group.ID
+	}
+
+	return req
+}
+
+func (r *UpdateFeedbackTemplateRequest) GetPath() string {
+	return fmt.Sprintf("feedback_templates/%s", url.PathEscape(r.FeedbackTemplateID))
+}
+
+func (r *UpdateFeedbackTemplateRequest) GetHTTPMethod() string {
+	return http.MethodPut
+}
+
+func (r *UpdateFeedbackTemplateRequest) GetBody() (io.Reader, error) {
+	return r.FeedbackTemplateFields.encode()
+}
+
+// Response for updating a feedback template.
+type UpdateFeedbackTemplateResponse struct {
+	BaseResponse
+
+	// The feedback template record.
+	FeedbackTemplate *model.FeedbackTemplate `json:"data"`
+}
+
+// Parameters for deleting a feedback template.
+type DeleteFeedbackTemplateRequest struct {
+	BaseRequest
+
+	// The feedback template id. This is required.
+	FeedbackTemplateID string
+}
+
+// Create a new DeleteFeedbackTemplateRequest with the required fields.
+func NewDeleteFeedbackTemplateRequest(feedbackTemplateID string) *DeleteFeedbackTemplateRequest {
+	return &DeleteFeedbackTemplateRequest{
+		FeedbackTemplateID: feedbackTemplateID,
+	}
+}
+
+func (r *DeleteFeedbackTemplateRequest) GetPath() string {
+	return fmt.Sprintf("feedback_templates/%s", url.PathEscape(r.FeedbackTemplateID))
+}
+
+func (r *DeleteFeedbackTemplateRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+// Response for deleting a feedback template.
+type DeleteFeedbackTemplateResponse struct {
+	BaseResponse
+}
+
+// Retrieve a single feedback template
+func (c *Client) GetFeedbackTemplate(ctx context.Context, req *GetFeedbackTemplateRequest) (*GetFeedbackTemplateResponse, error) {
+	var resp GetFeedbackTemplateResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// List all feedback templates
+//
+// Lists all active feedback templates in your Lever account.
+func (c *Client) ListFeedbackTemplates(ctx context.Context, req *ListFeedbackTemplatesRequest) (*ListFeedbackTemplatesResponse, error) {
+	var resp ListFeedbackTemplatesResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Create a feedback template
+//
+// Templates created via the API cannot be edited in the Lever application.
+func (c *Client) CreateFeedbackTemplate(ctx context.Context, req *CreateFeedbackTemplateRequest) (*CreateFeedbackTemplateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp CreateFeedbackTemplateResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update a feedback template
+//
+// This replaces the template; all fields must be specified. Only templates created via the API can
+// be updated.
+func (c *Client) UpdateFeedbackTemplate(ctx context.Context, req *UpdateFeedbackTemplateRequest) (*UpdateFeedbackTemplateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp UpdateFeedbackTemplateResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete a feedback template
+//
+// Only templates created via the API can be deleted.
+func (c *Client) DeleteFeedbackTemplate(ctx context.Context, req *DeleteFeedbackTemplateRequest) (*DeleteFeedbackTemplateResponse, error) {
+	var resp DeleteFeedbackTemplateResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
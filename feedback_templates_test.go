@@ -0,0 +1,220 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedbackTemplates(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{feedbackTemplateCodingExercise}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/feedback_templates"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": feedbackTemplateCodingExercise}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/feedback_templates/d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Feedback template was not found"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/feedback_templates/00000000-0000-0000-0000-000000000000"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// List feedback templates
+	listResp, err := c.ListFeedbackTemplates(ctx, NewListFeedbackTemplatesRequest())
+
+	if ta.NoError(err) && ta.Len(listResp.FeedbackTemplates, 1) {
+		template := listResp.FeedbackTemplates[0]
+		ta.Equal("d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2", template.ID)
+		ta.Equal("Coding exercise", template.Text)
+		if ta.NotNil(template.Group) {
+			ta.Equal("Engineering", template.Group.Name)
+		}
+		ta.Len(template.Fields, 4)
+	}
+
+	// Get a feedback template
+	getResp, err := c.GetFeedbackTemplate(ctx, NewGetFeedbackTemplateRequest("d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2"))
+
+	if ta.NoError(err) && ta.NotNil(getResp.FeedbackTemplate) && ta.Len(getResp.FeedbackTemplate.Fields, 4) {
+		fields := getResp.FeedbackTemplate.Fields
+
+		ta.Equal(model.FormFieldTypeScoreSystem, fields[0].Type)
+		ta.True(fields[0].Required)
+		if ta.Len(fields[0].Scores, 4) {
+			ta.Equal("Strong Hire", fields[0].Scores[3].Text)
+		}
+
+		ta.Equal(model.FormFieldTypeScorecard, fields[1].Type)
+		if ta.Len(fields[1].Skills, 2) {
+			ta.Equal("Problem solving", fields[1].Skills[0].Text)
+		}
+
+		ta.Equal(model.FormFieldTypeMultipleChoice, fields[2].Type)
+		ta.True(fields[2].IsOption("Go"))
+
+		ta.Equal(model.FormFieldTypeTextarea, fields[3].Type)
+	}
+
+	// Get a feedback template that does not exist
+	getResp, err = c.GetFeedbackTemplate(ctx, NewGetFeedbackTemplateRequest("00000000-0000-0000-0000-000000000000"))
+
+	if ta.Error(err) {
+		ta.Nil(getResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+}
+
+func TestCreateUpdateDeleteFeedbackTemplate(t *testing.T) {
+	ta := assert.New(t)
+
+	createBody := `{"text":"Coding exercise","instructions":"Grade the submission.","group":"5a3b9f1e-2c4d-4e6f-8a0b-1c2d3e4f5a6b","fields":[{"type":"score","text":"Would you hire?","required":true},{"type":"dropdown","text":"Language","options":[{"text":"Go"},{"text":"Python"}]}]}` + "\n"
+	updateBody := `{"text":"Coding exercise (v2)","instructions":"Grade the submission.","group":"5a3b9f1e-2c4d-4e6f-8a0b-1c2d3e4f5a6b","fields":[{"id":"0e8a8c4f-5b6d-4f0e-9a4b-7c1d2e3f4a5b","type":"score","text":"Would you hire?","required":true}]}` + "\n"
+
+	created := map[string]any{
+		"id":           "d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2",
+		"text":         "Coding exercise",
+		"instructions": "Grade the submission.",
+		"group":        map[string]any{"id": "5a3b9f1e-2c4d-4e6f-8a0b-1c2d3e4f5a6b", "name": "Engineering"},
+		"fields": []map[string]any{
+			{"id": "0e8a8c4f-5b6d-4f0e-9a4b-7c1d2e3f4a5b", "type": "score", "text": "Would you hire?", "required": true},
+		},
+	}
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": created}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/feedback_templates"),
+			testclient.ExpectBody(createBody),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": created}),
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath("/v1/feedback_templates/d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2"),
+			testclient.ExpectBody(updateBody),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath("/v1/feedback_templates/d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Create a feedback template
+	createReq := NewCreateFeedbackTemplateRequest("Coding exercise", []model.FormField{
+		{Type: model.FormFieldTypeScore, Text: "Would you hire?", Required: true},
+		{Type: model.FormFieldTypeDropdown, Text: "Language", Options: []model.FormFieldOption{{Text: "Go"}, {Text: "Python"}}},
+	})
+	createReq.Instructions = "Grade the submission."
+	createReq.GroupID = "5a3b9f1e-2c4d-4e6f-8a0b-1c2d3e4f5a6b"
+	createResp, err := c.CreateFeedbackTemplate(ctx, createReq)
+
+	if ta.NoError(err) && ta.NotNil(createResp.FeedbackTemplate) {
+		ta.Equal("d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2", createResp.FeedbackTemplate.ID)
+	}
+
+	// Update a feedback template
+	updateReq := NewUpdateFeedbackTemplateRequestFromFeedbackTemplate(createResp.FeedbackTemplate)
+	updateReq.Text = "Coding exercise (v2)"
+	_, err = c.UpdateFeedbackTemplate(ctx, updateReq)
+	ta.NoError(err)
+
+	// Invalid requests are rejected before anything is sent
+	invalidReq := NewCreateFeedbackTemplateRequest("", []model.FormField{
+		{Type: model.FormFieldTypeMultipleSelect, Text: "Strengths"},
+		{Type: model.FormFieldTypeScorecard, Text: "Scorecard"},
+	})
+	_, err = c.CreateFeedbackTemplate(ctx, invalidReq)
+	if ta.Error(err) {
+		ta.ErrorContains(err, "text is required")
+		ta.ErrorContains(err, "field 0: multiple-select field requires options")
+		ta.ErrorContains(err, "field 1: scorecard field requires skills")
+	}
+
+	// Delete a feedback template
+	_, err = c.DeleteFeedbackTemplate(ctx, NewDeleteFeedbackTemplateRequest("d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2"))
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+var feedbackTemplateCodingExercise = map[string]any{
+	"id":           "d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2",
+	"text":         "Coding exercise",
+	"instructions": "Grade the submission.",
+	"group":        map[string]any{"id": "5a3b9f1e-2c4d-4e6f-8a0b-1c2d3e4f5a6b", "name": "Engineering"},
+	"createdAt":    1423187881576,
+	"updatedAt":    1423187881576,
+	"fields": []map[string]any{
+		{
+			"id":       "0e8a8c4f-5b6d-4f0e-9a4b-7c1d2e3f4a5b",
+			"type":     "score-system",
+			"text":     "Rating",
+			"required": true,
+			"scores": []map[string]any{
+				{"text": "Strong No Hire"},
+				{"text": "No Hire"},
+				{"text": "Hire"},
+				{"text": "Strong Hire"},
+			},
+		},
+		{
+			"id":   "1f9b9d5a-6c7e-4a1f-8b5c-8d2e3f4a5b6c",
+			"type": "scorecard",
+			"text": "Scorecard",
+			"scores": []map[string]any{
+				{"text": "1"}, {"text": "2"}, {"text": "3"}, {"text": "4"},
+			},
+			"skills": []map[string]any{
+				{"text": "Problem solving", "description": "Breaks the problem down"},
+				{"text": "Code quality"},
+			},
+		},
+		{
+			"id":      "2a0c0e6b-7d8f-4b2a-9c6d-9e3f4a5b6c7d",
+			"type":    "multiple-choice",
+			"text":    "Language used",
+			"options": []map[string]any{{"text": "Go"}, {"text": "Python"}},
+		},
+		{
+			"id":   "7b2c4d6e-8f0a-4b1c-9d3e-5f7a9b1c3d5e",
+			"type": "textarea",
+			"text": "Notes",
+		},
+	},
+}
@@ -0,0 +1,174 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedbackForms(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{feedbackOnSite}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/feedback"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": expandFeedbackForm(feedbackOnSite, "user")}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/feedback/b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40"),
+			testclient.ExpectQuery("expand", "user"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Feedback was not found"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/feedback/00000000-0000-0000-0000-000000000000"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// List feedback forms
+	listReq := NewListFeedbackFormsRequest("250d8f03-738a-4bba-a671-8a3d73477145")
+	listResp, err := c.ListFeedbackForms(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.FeedbackForms, 1) {
+		feedbackForm := listResp.FeedbackForms[0]
+		ta.Equal("b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40", feedbackForm.ID)
+		ta.Equal("d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2", feedbackForm.BaseTemplateID)
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", feedbackForm.UserID)
+		ta.Nil(feedbackForm.User)
+		ta.Equal("8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0", feedbackForm.PanelID)
+		ta.Equal("3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6", feedbackForm.InterviewID)
+		ta.Len(feedbackForm.Forms, 1)
+	}
+
+	// Get a feedback form with the user expanded
+	getReq := NewGetFeedbackFormRequest("250d8f03-738a-4bba-a671-8a3d73477145", "b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40")
+	getReq.Expand = []string{"user"}
+	getResp, err := c.GetFeedbackForm(ctx, getReq)
+
+	if ta.NoError(err) && ta.NotNil(getResp.FeedbackForm) {
+		if ta.NotNil(getResp.FeedbackForm.User) {
+			ta.Equal(getResp.FeedbackForm.UserID, getResp.FeedbackForm.User.ID)
+		}
+	}
+
+	// Get a feedback form that does not exist
+	getReq = NewGetFeedbackFormRequest("250d8f03-738a-4bba-a671-8a3d73477145", "00000000-0000-0000-0000-000000000000")
+	getResp, err = c.GetFeedbackForm(ctx, getReq)
+
+	if ta.Error(err) {
+		ta.Nil(getResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+}
+
+func TestCreateUpdateDeleteFeedbackForm(t *testing.T) {
+	ta := assert.New(t)
+
+	const feedbackPath = "/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/feedback"
+	const performAsID = "ecdb6670-d9f3-4b87-8267-1cde26d1bc42"
+
+	createBody := `{"baseTemplateId":"d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2","panel":"8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0","interview":"3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6","fieldValues":[{"id":"0e8a8c4f-5b6d-4f0e-9a4b-7c1d2e3f4a5b","value":3},{"id":"7b2c4d6e-8f0a-4b1c-9d3e-5f7a9b1c3d5e","value":"Solved the exercise & explained trade-offs."}]}` + "\n"
+	updateBody := `{"fieldValues":[{"id":"0e8a8c4f-5b6d-4f0e-9a4b-7c1d2e3f4a5b","value":4}],"completedAt":1423597600000}` + "\n"
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": feedbackOnSite}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath(feedbackPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectBody(createBody),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": feedbackOnSite}),
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath(feedbackPath+"/b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40"),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectBody(updateBody),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath(feedbackPath+"/b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40"),
+			testclient.ExpectQuery("perform_as", performAsID),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Create a feedback form
+	createReq := NewCreateFeedbackFormRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2")
+	createReq.PanelID = "8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0"
+	createReq.InterviewID = "3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6"
+	createReq.FieldValues = []model.FeedbackFieldValue{
+		{ID: "0e8a8c4f-5b6d-4f0e-9a4b-7c1d2e3f4a5b", Value: 3},
+		{ID: "7b2c4d6e-8f0a-4b1c-9d3e-5f7a9b1c3d5e", Value: "Solved the exercise & explained trade-offs."},
+	}
+	createResp, err := c.CreateFeedbackForm(ctx, createReq)
+
+	if ta.NoError(err) && ta.NotNil(createResp.FeedbackForm) {
+		ta.Equal("b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40", createResp.FeedbackForm.ID)
+	}
+
+	// Update a feedback form
+	completedAt := int64(1423597600000)
+	updateReq := NewUpdateFeedbackFormRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40")
+	updateReq.FieldValues = []model.FeedbackFieldValue{{ID: "0e8a8c4f-5b6d-4f0e-9a4b-7c1d2e3f4a5b", Value: 4}}
+	updateReq.CompletedAt = &completedAt
+	updateResp, err := c.UpdateFeedbackForm(ctx, updateReq)
+
+	if ta.NoError(err) && ta.NotNil(updateResp.FeedbackForm) {
+		ta.Equal("b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40", updateResp.FeedbackForm.ID)
+	}
+
+	// Delete a feedback form
+	deleteReq := NewDeleteFeedbackFormRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40")
+	_, err = c.DeleteFeedbackForm(ctx, deleteReq)
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+// expandFeedbackForm expands the specified fields in the feedback form data.
+func expandFeedbackForm(orig map[string]any, fields ...string) map[string]any {
+	expanded := make(map[string]any)
+	for k, v := range orig {
+		expanded[k] = v
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "user":
+			expanded["user"] = expandUser(expanded["user"].(string))
+		}
+	}
+
+	return expanded
+}
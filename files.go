@@ -0,0 +1,316 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever opportunity files client interface
+type FilesClientInterface interface {
+	ClientInterface
+
+	// Retrieve information about a single file.
+	//
+	// This endpoint retrieves the metadata for a single file. To download a file, see
+	// DownloadOpportunityFile.
+	GetOpportunityFile(ctx context.Context, req *GetOpportunityFileRequest) (*GetOpportunityFileResponse, error)
+
+	// Lists all files associated with an opportunity.
+	ListOpportunityFiles(ctx context.Context, req *ListOpportunityFilesRequest) (*ListOpportunityFilesResponse, error)
+
+	// Download a file.
+	//
+	// Downloads a file if it exists.
+	//
+	// The caller is responsible for closing the response body after consuming it.
+	DownloadOpportunityFile(ctx context.Context, req *DownloadOpportunityFileRequest) (*http.Response, error)
+
+	// Upload a file.
+	//
+	// Uploads a file and attaches it to an opportunity.
+	UploadOpportunityFile(ctx context.Context, req *UploadOpportunityFileRequest) (*UploadOpportunityFileResponse, error)
+
+	// Delete a file.
+	//
+	// Deletes a file from an opportunity.
+	DeleteOpportunityFile(ctx context.Context, req *DeleteOpportunityFileRequest) (*DeleteOpportunityFileResponse, error)
+}
+
+// Parameters for retrieving a single file.
+type GetOpportunityFileRequest struct {
+	BaseRequest
+
+	// The ID of the opportunity associated with the file.
+	OpportunityID string
+
+	// The ID of the file to retrieve.
+	ID string
+}
+
+// Create a new get file request with the required fields.
+func NewGetOpportunityFileRequest(opportunityID, id string) *GetOpportunityFileRequest {
+	return &GetOpportunityFileRequest{
+		OpportunityID: opportunityID,
+		ID:            id,
+	}
+}
+
+func (r *GetOpportunityFileRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/files/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.ID))
+}
+
+// Response for retrieving a single file.
+type GetOpportunityFileResponse struct {
+	BaseResponse
+
+	// The file.
+	Data model.File `json:"data"`
+}
+
+// Parameters for listing files.
+type ListOpportunityFilesRequest struct {
+	BaseListRequest
+
+	// The opportunity id associated with the files to list.
+	OpportunityID string
+
+	// If set, filter files by the timestamp they were uploaded at. If only UploadedAtStart is
+	// specified, all files uploaded from that timestamp (inclusive) to the present will be
+	// included. If only UploadedAtEnd is specified, all files uploaded before that timestamp
+	// (inclusive) are included.
+	UploadedAtStart *int64
+	UploadedAtEnd   *int64
+}
+
+// Create a new list files request with the required fields.
+func NewListOpportunityFilesRequest(opportunityID string) *ListOpportunityFilesRequest {
+	return &ListOpportunityFilesRequest{
+		OpportunityID: opportunityID,
+	}
+}
+
+func (r *ListOpportunityFilesRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/files", url.PathEscape(r.OpportunityID))
+}
+
+func (r *ListOpportunityFilesRequest) AddAPIQueryParams(v *url.Values) {
+	r.BaseListRequest.AddAPIQueryParams(v)
+
+	if r.UploadedAtStart != nil {
+		v.Add(paramUploadedAtStart, fmt.Sprintf("%d", *r.UploadedAtStart))
+	}
+
+	if r.UploadedAtEnd != nil {
+		v.Add(paramUploadedAtEnd, fmt.Sprintf("%d", *r.UploadedAtEnd))
+	}
+}
+
+// Response for listing files.
+type ListOpportunityFilesResponse struct {
+	BaseListResponse
+
+	// The files associated with the opportunity.
+	Data []model.File `json:"data"`
+}
+
+// Parameters for downloading a single file.
+type DownloadOpportunityFileRequest struct {
+	BaseRequest
+
+	// The ID of the opportunity associated with the file.
+	OpportunityID string
+
+	// The ID of the file to download.
+	ID string
+}
+
+// Create a new download file request with the required fields.
+func NewDownloadOpportunityFileRequest(opportunityID, id string) *DownloadOpportunityFileRequest {
+	return &DownloadOpportunityFileRequest{
+		OpportunityID: opportunityID,
+		ID:            id,
+	}
+}
+
+func (r *DownloadOpportunityFileRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/files/%s/download", url.PathEscape(r.OpportunityID), url.PathEscape(r.ID))
+}
+
+// Parameters for uploading a file.
+type UploadOpportunityFileRequest struct {
+	BaseRequest
+
+	// The ID of the opportunity to attach the file to.
+	OpportunityID string
+
+	// Perform this upload on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// The file to upload. This is required. The file contents are closed after the file has been
+	// sent.
+	File *model.Reader
+
+	// Content type value for the multipart/form-data boundary string
+	contentType string
+}
+
+// Create a new upload file request with the required fields.
+func NewUploadOpportunityFileRequest(performAsID, opportunityID string, file *model.Reader) *UploadOpportunityFileRequest {
+	return &UploadOpportunityFileRequest{
+		OpportunityID: opportunityID,
+		PerformAsID:   performAsID,
+		File:          file,
+	}
+}
+
+func (r *UploadOpportunityFileRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/files", url.PathEscape(r.OpportunityID))
+}
+
+func (r *UploadOpportunityFileRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *UploadOpportunityFileRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *UploadOpportunityFileRequest) GetBody() (io.Reader, error) {
+	if r.File == nil {
+		return nil, errors.New("file is required")
+	}
+
+	body, contentType := newMultipartBody(r.writeBody)
+	r.contentType = contentType
+	return body, nil
+}
+
+func (r *UploadOpportunityFileRequest) GetContentType() string {
+	return r.contentType
+}
+
+// writeBody writes the body of the request to the provided writer.
+func (r *UploadOpportunityFileRequest) writeBody(w *multipart.Writer) error {
+	return writeMultipartFile(w, "file", r.File)
+}
+
+// Response for uploading a file.
+type UploadOpportunityFileResponse struct {
+	BaseResponse
+
+	// The uploaded file.
+	Data model.File `json:"data"`
+}
+
+// Parameters for deleting a file.
+type DeleteOpportunityFileRequest struct {
+	BaseRequest
+
+	// The ID of the opportunity associated with the file.
+	OpportunityID string
+
+	// The ID of the file to delete.
+	ID string
+
+	// Perform this delete on behalf of a specified user. This is required.
+	PerformAsID string
+}
+
+// Create a new delete file request with the required fields.
+func NewDeleteOpportunityFileRequest(performAsID, opportunityID, id string) *DeleteOpportunityFileRequest {
+	return &DeleteOpportunityFileRequest{
+		OpportunityID: opportunityID,
+		ID:            id,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *DeleteOpportunityFileRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/files/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.ID))
+}
+
+func (r *DeleteOpportunityFileRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+func (r *DeleteOpportunityFileRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+// Response for deleting a file.
+type DeleteOpportunityFileResponse struct {
+	BaseResponse
+}
+
+// Retrieve information about a single file.
+//
+// This endpoint retrieves the metadata for a single file. To download a file, see
+// DownloadOpportunityFile.
+func (c *Client) GetOpportunityFile(ctx context.Context, req *GetOpportunityFileRequest) (*GetOpportunityFileResponse, error) {
+	var resp GetOpportunityFileResponse
+
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Lists all files associated with an opportunity.
+func (c *Client) ListOpportunityFiles(ctx context.Context, req *ListOpportunityFilesRequest) (*ListOpportunityFilesResponse, error) {
+	var resp ListOpportunityFilesResponse
+
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Download a file.
+//
+// Downloads a file if it exists.
+func (c *Client) DownloadOpportunityFile(ctx context.Context, req *DownloadOpportunityFileRequest) (*http.Response, error) {
+	return c.download(ctx, req)
+}
+
+// Upload a file.
+//
+// Uploads a file and attaches it to an opportunity.
+func (c *Client) UploadOpportunityFile(ctx context.Context, req *UploadOpportunityFileRequest) (*UploadOpportunityFileResponse, error) {
+	var resp UploadOpportunityFileResponse
+
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete a file.
+//
+// Deletes a file from an opportunity.
+func (c *Client) DeleteOpportunityFile(ctx context.Context, req *DeleteOpportunityFileRequest) (*DeleteOpportunityFileResponse, error) {
+	var resp DeleteOpportunityFileResponse
+
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
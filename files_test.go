@@ -0,0 +1,202 @@
+package lever
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpportunityFiles(t *testing.T) {
+	ta := assert.New(t)
+
+	const filesPath = "/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/files"
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{filePortfolio}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(filesPath),
+			testclient.ExpectQuery("uploadedAtStart", "1423187881000"),
+			testclient.ExpectQuery("uploadedAtEnd", "1423187882000"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": filePortfolio}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(filesPath+"/c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			"%PDF-1.4 portfolio",
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(filesPath+"/c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f/download"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"File was not found"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(filesPath+"/00000000-0000-0000-0000-000000000000/download"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": filePortfolio}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath(filesPath),
+			testclient.ExpectQuery("perform_as", "df0adaa6-172c-4cd6-8520-49b203660fe1"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath(filesPath+"/c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f"),
+			testclient.ExpectQuery("perform_as", "df0adaa6-172c-4cd6-8520-49b203660fe1"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// List files uploaded in a time range
+	uploadedAtStart := int64(1423187881000)
+	uploadedAtEnd := int64(1423187882000)
+	listReq := NewListOpportunityFilesRequest("250d8f03-738a-4bba-a671-8a3d73477145")
+	listReq.UploadedAtStart = &uploadedAtStart
+	listReq.UploadedAtEnd = &uploadedAtEnd
+	listResp, err := c.ListOpportunityFiles(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.Data, 1) {
+		file := listResp.Data[0]
+		ta.Equal("c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f", file.ID)
+		ta.Equal("portfolio.pdf", file.Name)
+		ta.Equal("pdf", file.Ext)
+		ta.Equal("processed", file.Status)
+		if ta.NotNil(file.Size) {
+			ta.Equal(int64(18432), *file.Size)
+		}
+	}
+
+	// Get a file
+	getResp, err := c.GetOpportunityFile(ctx, NewGetOpportunityFileRequest("250d8f03-738a-4bba-a671-8a3d73477145", "c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f"))
+
+	if ta.NoError(err) {
+		ta.Equal("c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f", getResp.Data.ID)
+	}
+
+	// Download a file
+	downloadResp, err := c.DownloadOpportunityFile(ctx, NewDownloadOpportunityFileRequest("250d8f03-738a-4bba-a671-8a3d73477145", "c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f"))
+
+	if ta.NoError(err) {
+		body, err := io.ReadAll(downloadResp.Body)
+		downloadResp.Body.Close()
+		ta.NoError(err)
+		ta.Equal("%PDF-1.4 portfolio", string(body))
+	}
+
+	// Download a file that does not exist
+	downloadResp, err = c.DownloadOpportunityFile(ctx, NewDownloadOpportunityFileRequest("250d8f03-738a-4bba-a671-8a3d73477145", "00000000-0000-0000-0000-000000000000"))
+
+	if ta.Error(err) {
+		ta.Nil(downloadResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+
+	// Upload a file
+	uploadReq := NewUploadOpportunityFileRequest("df0adaa6-172c-4cd6-8520-49b203660fe1", "250d8f03-738a-4bba-a671-8a3d73477145", &model.Reader{
+		Name:     "portfolio.pdf",
+		Contents: io.NopCloser(strings.NewReader("%PDF-1.4 portfolio")),
+	})
+	uploadResp, err := c.UploadOpportunityFile(ctx, uploadReq)
+
+	if ta.NoError(err) {
+		ta.Equal("c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f", uploadResp.Data.ID)
+	}
+
+	// Delete a file
+	_, err = c.DeleteOpportunityFile(ctx, NewDeleteOpportunityFileRequest("df0adaa6-172c-4cd6-8520-49b203660fe1", "250d8f03-738a-4bba-a671-8a3d73477145", "c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f"))
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+func TestUploadOpportunityFileBody(t *testing.T) {
+	ta := assert.New(t)
+
+	req := NewUploadOpportunityFileRequest("df0adaa6-172c-4cd6-8520-49b203660fe1", "250d8f03-738a-4bba-a671-8a3d73477145", &model.Reader{
+		Name:     "portfolio.pdf",
+		Contents: io.NopCloser(strings.NewReader("%PDF-1.4 portfolio")),
+	})
+
+	body, err := req.GetBody()
+	if !ta.NoError(err) {
+		return
+	}
+
+	// The body must end once the form has been written.
+	data, err := io.ReadAll(body)
+	if !ta.NoError(err) {
+		return
+	}
+
+	_, params, err := mime.ParseMediaType(req.GetContentType())
+	if !ta.NoError(err) {
+		return
+	}
+
+	form, err := multipart.NewReader(bytes.NewReader(data), params["boundary"]).ReadForm(1 << 20)
+	if !ta.NoError(err) {
+		return
+	}
+
+	if ta.Len(form.File["file"], 1) {
+		ta.Equal("portfolio.pdf", form.File["file"][0].Filename)
+		ta.Equal("application/pdf", form.File["file"][0].Header.Get("Content-Type"))
+	}
+
+	// Errors reading the file are passed to the reader of the body.
+	req.File = &model.Reader{
+		Name:     "portfolio.pdf",
+		Contents: io.NopCloser(iotest.ErrReader(errReadFailed)),
+	}
+
+	body, err = req.GetBody()
+	if ta.NoError(err) {
+		_, err = io.ReadAll(body)
+		ta.ErrorIs(err, errReadFailed)
+	}
+
+	// A file is required.
+	req.File = nil
+	_, err = req.GetBody()
+	ta.Error(err)
+}
+
+var errReadFailed = errors.New("read failed")
+
+var filePortfolio = map[string]any{
+	"id":          "c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f",
+	"downloadUrl": "https://api.lever.co/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/files/c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f/download",
+	"ext":         "pdf",
+	"name":        "portfolio.pdf",
+	"uploadedAt":  1423187881576,
+	"status":      "processed",
+	"size":        18432,
+}
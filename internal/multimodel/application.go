@@ -0,0 +1,147 @@
+package multimodel
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The Application model, but with expandable fields left unparsed.
+type Application struct {
+	// Application UID
+	ID string `json:"id,omitempty"`
+
+	// Opportunity profile associated with an application.
+	OpportunityID string `json:"opportunityId,omitempty"`
+
+	// Datestamp when application was created in Lever.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// An application can be of type referral, user, or posting.
+	Type string `json:"type,omitempty"`
+
+	// The job posting (ID or struct) applied to.
+	Posting json.RawMessage `json:"posting,omitempty"`
+
+	// The user (ID or struct) of the owner of the job posting at the time when the candidate
+	// applied to that job.
+	PostingOwner json.RawMessage `json:"postingOwner,omitempty"`
+
+	// The user (ID or struct) of the hiring manager of the job posting at the time when the
+	// candidate applied to that job.
+	PostingHiringManager json.RawMessage `json:"postingHiringManager,omitempty"`
+
+	// If the application is of type referral, the user (ID or struct) who made the referral.
+	User json.RawMessage `json:"user,omitempty"`
+
+	// Name of candidate who applied.
+	Name string `json:"name,omitempty"`
+
+	// Candidate email
+	Email string `json:"email,omitempty"`
+
+	// Candidate phone number
+	Phone *model.Phone `json:"phone,omitempty"`
+
+	// Candidate's current company or organization
+	Company string `json:"company,omitempty"`
+
+	// List of candidate links (e.g. personal website, LinkedIn profile, etc.)
+	Links []string `json:"links,omitempty"`
+
+	// Any additional comments from candidate included in job application
+	Comments string `json:"comments,omitempty"`
+
+	// An array of customized forms.
+	CustomQuestions []any `json:"customQuestions,omitempty"`
+
+	// Application archived status
+	Archived *model.Archived `json:"archived,omitempty"`
+
+	// If the application was archived as hired against a requisition, this is the data related to
+	// the requisition.
+	RequisitionForHire *model.ApplicationRequisitionForHire `json:"requisitionForHire,omitempty"`
+}
+
+// Populate a regular [model.Application] from this [multimodel.Application].
+func (a *Application) ToModel(result *model.Application) error {
+	// Fields that map 1:1
+	result.ID = a.ID
+	result.OpportunityID = a.OpportunityID
+	result.CreatedAt = a.CreatedAt
+	result.Type = a.Type
+	result.Name = a.Name
+	result.Email = a.Email
+	result.Phone = a.Phone
+	result.Company = a.Company
+	result.Links = a.Links
+	result.Comments = a.Comments
+	result.CustomQuestions = a.CustomQuestions
+	result.Archived = a.Archived
+	result.RequisitionForHire = a.RequisitionForHire
+
+	postingID, posting, err := unmarshalPostingOrID(a.Posting)
+	if err != nil {
+		return err
+	}
+	result.PostingID = postingID
+	result.Posting = posting
+
+	postingOwnerID, postingOwner, err := unmarshalUserOrID(a.PostingOwner)
+	if err != nil {
+		return err
+	}
+	result.PostingOwnerID = postingOwnerID
+	result.PostingOwner = postingOwner
+
+	postingHiringManagerID, postingHiringManager, err := unmarshalUserOrID(a.PostingHiringManager)
+	if err != nil {
+		return err
+	}
+	result.PostingHiringManagerID = postingHiringManagerID
+	result.PostingHiringManager = postingHiringManager
+
+	userID, user, err := unmarshalUserOrID(a.User)
+	if err != nil {
+		return err
+	}
+	result.UserID = userID
+	result.User = user
+
+	return nil
+}
+
+// Unmarshal an array of application IDs or an array of applications.
+//   - If the raw message is empty or null, returns (nil, nil, nil).
+//   - If the raw message is an array of strings, returns (ids, nil, nil).
+//   - If the raw message is an array of applications, returns (ids, applications, nil).
+func unmarshalArrayOfApplicationsOrIDs(raw json.RawMessage) ([]string, []model.Application, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil, nil
+	}
+
+	var ids []string
+	var rawApplications []Application
+
+	// Try unmarshalling as an array of applications first
+	if err := json.Unmarshal(raw, &rawApplications); err != nil {
+		// Can't unmarshal as applications; try unmarshalling as IDs.
+		if err := json.Unmarshal(raw, &ids); err != nil {
+			return nil, nil, err
+		}
+
+		return ids, nil, nil
+	}
+
+	applications := make([]model.Application, len(rawApplications))
+	ids = make([]string, len(rawApplications))
+	for i := range rawApplications {
+		if err := rawApplications[i].ToModel(&applications[i]); err != nil {
+			return nil, nil, err
+		}
+
+		ids[i] = applications[i].ID
+	}
+
+	return ids, applications, nil
+}
@@ -0,0 +1,87 @@
+package multimodel
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmptyApplicationArrayUnmarshalling(t *testing.T) {
+	ta := assert.New(t)
+
+	ids, applications, err := unmarshalArrayOfApplicationsOrIDs(json.RawMessage(""))
+	if ta.NoError(err) {
+		ta.Empty(ids)
+		ta.Empty(applications)
+	}
+
+	ids, applications, err = unmarshalArrayOfApplicationsOrIDs(json.RawMessage("null"))
+	if ta.NoError(err) {
+		ta.Empty(ids)
+		ta.Empty(applications)
+	}
+}
+
+func TestApplicationArrayUnmarshalling(t *testing.T) {
+	ta := assert.New(t)
+
+	ids, applications, err := unmarshalArrayOfApplicationsOrIDs(json.RawMessage(`["cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"]`))
+	if ta.NoError(err) {
+		ta.Equal([]string{"cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"}, ids)
+		ta.Nil(applications)
+	}
+
+	ids, applications, err = unmarshalArrayOfApplicationsOrIDs(json.RawMessage(`[
+	{
+		"id": "cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa",
+		"opportunityId": "250d8f03-738a-4bba-a671-8a3d73477145",
+		"type": "posting",
+		"posting": {
+			"id": "f2f01e16-27f8-4711-a728-7d49499795a0",
+			"text": "Customer Success Manager",
+			"owner": "ecdb6670-d9f3-4b87-8267-1cde26d1bc42"
+		},
+		"postingOwner": {
+			"id": "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+			"name": "Rachel Green"
+		},
+		"postingHiringManager": null,
+		"user": "df0adaa6-172c-4cd6-8520-49b203660fe1"
+	}
+]`))
+	if ta.NoError(err) {
+		ta.Equal([]string{"cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"}, ids)
+		if ta.Len(applications, 1) {
+			application := applications[0]
+			ta.Equal("250d8f03-738a-4bba-a671-8a3d73477145", application.OpportunityID)
+
+			ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", application.PostingID)
+			if ta.NotNil(application.Posting) {
+				ta.Equal("Customer Success Manager", application.Posting.Text)
+				ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", application.Posting.OwnerID)
+			}
+
+			ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", application.PostingOwnerID)
+			if ta.NotNil(application.PostingOwner) {
+				ta.Equal("Rachel Green", application.PostingOwner.Name)
+			}
+
+			ta.Empty(application.PostingHiringManagerID)
+			ta.Nil(application.PostingHiringManager)
+
+			ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", application.UserID)
+			ta.Nil(application.User)
+		}
+	}
+}
+
+func TestInvalidApplicationArrayUnmarshalling(t *testing.T) {
+	ta := assert.New(t)
+
+	_, _, err := unmarshalArrayOfApplicationsOrIDs(json.RawMessage(`{}`))
+	ta.Error(err)
+
+	_, _, err = unmarshalArrayOfApplicationsOrIDs(json.RawMessage(`[{"posting": []}]`))
+	ta.Error(err)
+}
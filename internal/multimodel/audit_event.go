@@ -0,0 +1,91 @@
+package multimodel
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The AuditEvent model, but with the metadata left unparsed.
+type AuditEvent struct {
+	// Audit event UID
+	ID string `json:"id,omitempty"`
+
+	// The event type (e.g. user.created).
+	Type string `json:"type,omitempty"`
+
+	// The user who performed the action, if the action was performed by a user.
+	Actor *model.AuditEventActor `json:"user,omitempty"`
+
+	// The object the action was performed on.
+	Target *model.AuditEventTarget `json:"target,omitempty"`
+
+	// Datetime when the event occurred.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Metadata specific to the event type.
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+}
+
+// Populate a regular [model.AuditEvent] from this [AuditEvent].
+func (e *AuditEvent) ToModel(result *model.AuditEvent) error {
+	// Fields that map 1:1
+	result.ID = e.ID
+	result.Type = e.Type
+	result.Actor = e.Actor
+	result.Target = e.Target
+	result.CreatedAt = e.CreatedAt
+
+	// Parse the metadata field. If Lever changes the metadata for a known event type so that it no
+	// longer matches the typed struct, leave Metadata nil rather than failing the whole response;
+	// the metadata is still available in RawMetadata.
+	metadata, err := unmarshalAuditEventMetadata(e.Type, e.Metadata)
+	if err == nil {
+		result.Metadata = metadata
+	}
+	if len(e.Metadata) > 0 && string(e.Metadata) != "null" {
+		result.RawMetadata = e.Metadata
+	}
+
+	return nil
+}
+
+// Unmarshal the metadata for an audit event of the given type.
+//   - If the raw message is empty or null, returns (nil, nil).
+//   - If the event type is unknown, returns (nil, nil).
+//   - Otherwise, returns a pointer to the metadata struct for the event type.
+func unmarshalAuditEventMetadata(eventType string, raw json.RawMessage) (any, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var metadata any
+	switch eventType {
+	case model.AuditEventTypeUserCreated:
+		metadata = &model.AuditEventUserCreatedMetadata{}
+
+	case model.AuditEventTypeUserDeactivated, model.AuditEventTypeUserReactivated:
+		metadata = &model.AuditEventUserStatusMetadata{}
+
+	case model.AuditEventTypeUserAccessRoleUpdated:
+		metadata = &model.AuditEventUserAccessRoleMetadata{}
+
+	case model.AuditEventTypeUserLoginSucceeded, model.AuditEventTypeUserLoginFailed:
+		metadata = &model.AuditEventLoginMetadata{}
+
+	case model.AuditEventTypeAPICredentialCreated, model.AuditEventTypeAPICredentialDeleted:
+		metadata = &model.AuditEventAPICredentialMetadata{}
+
+	case model.AuditEventTypeDataExportCreated:
+		metadata = &model.AuditEventDataExportMetadata{}
+
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(raw, metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,77 @@
+package multimodel
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEventMetadataUnmarshalling(t *testing.T) {
+	ta := assert.New(t)
+
+	metadata, err := unmarshalAuditEventMetadata(model.AuditEventTypeUserAccessRoleUpdated, json.RawMessage(`{"previousAccessRole":"interviewer","accessRole":"admin"}`))
+	if ta.NoError(err) && ta.IsType(&model.AuditEventUserAccessRoleMetadata{}, metadata) {
+		ta.Equal("interviewer", metadata.(*model.AuditEventUserAccessRoleMetadata).PreviousAccessRole)
+		ta.Equal("admin", metadata.(*model.AuditEventUserAccessRoleMetadata).AccessRole)
+	}
+
+	metadata, err = unmarshalAuditEventMetadata(model.AuditEventTypeUserLoginFailed, json.RawMessage(`{"ipAddress":"203.0.113.7","method":"password","failureReason":"invalid_password"}`))
+	if ta.NoError(err) && ta.IsType(&model.AuditEventLoginMetadata{}, metadata) {
+		ta.Equal("invalid_password", metadata.(*model.AuditEventLoginMetadata).FailureReason)
+	}
+}
+
+func TestEmptyAuditEventMetadataUnmarshalling(t *testing.T) {
+	ta := assert.New(t)
+
+	metadata, err := unmarshalAuditEventMetadata(model.AuditEventTypeUserCreated, json.RawMessage(""))
+	ta.NoError(err)
+	ta.Nil(metadata)
+
+	metadata, err = unmarshalAuditEventMetadata(model.AuditEventTypeUserCreated, json.RawMessage("null"))
+	ta.NoError(err)
+	ta.Nil(metadata)
+}
+
+func TestUnknownAuditEventMetadataUnmarshalling(t *testing.T) {
+	ta := assert.New(t)
+
+	event := AuditEvent{
+		ID:       "b6a8f0a2-1c3d-4e5f-8a7b-9c0d1e2f3a4b",
+		Type:     "posting.published",
+		Metadata: json.RawMessage(`{"postingId":"f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d","visibility":"public"}`),
+	}
+
+	var result model.AuditEvent
+	if ta.NoError(event.ToModel(&result)) {
+		ta.Nil(result.Metadata)
+		ta.JSONEq(`{"postingId":"f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d","visibility":"public"}`, string(result.RawMetadata))
+	}
+}
+
+func TestInvalidAuditEventMetadataUnmarshalling(t *testing.T) {
+	ta := assert.New(t)
+
+	_, err := unmarshalAuditEventMetadata(model.AuditEventTypeAPICredentialCreated, json.RawMessage(`{"permissions":"all"}`))
+	ta.Error(err)
+}
+
+func TestMismatchedAuditEventMetadataUnmarshalling(t *testing.T) {
+	ta := assert.New(t)
+
+	// A known event type whose metadata has changed shape must not fail decoding.
+	event := AuditEvent{
+		ID:       "b6a8f0a2-1c3d-4e5f-8a7b-9c0d1e2f3a4b",
+		Type:     model.AuditEventTypeAPICredentialCreated,
+		Metadata: json.RawMessage(`{"permissions":"all"}`),
+	}
+
+	var result model.AuditEvent
+	if ta.NoError(event.ToModel(&result)) {
+		ta.Equal(model.AuditEventTypeAPICredentialCreated, result.Type)
+		ta.Nil(result.Metadata)
+		ta.JSONEq(`{"permissions":"all"}`, string(result.RawMetadata))
+	}
+}
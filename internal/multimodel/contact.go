@@ -0,0 +1,31 @@
+package multimodel
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Unmarshal a contact ID or contact.
+//   - If the raw message is empty or null, returns ("", nil, nil).
+//   - If the raw message is a string, returns (id, nil, nil).
+//   - If the raw message is a contact, returns (contact.ID, &contact, nil).
+func unmarshalContactOrID(raw json.RawMessage) (string, *model.Contact, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil, nil
+	}
+
+	// Try unmarshalling as a contact first
+	var contact model.Contact
+	if err := json.Unmarshal(raw, &contact); err != nil {
+		// Can't unmarshal as a contact; try unmarshalling as an ID.
+		var id string
+		if err := json.Unmarshal(raw, &id); err != nil {
+			return "", nil, err
+		}
+
+		return id, nil, nil
+	}
+
+	return contact.ID, &contact, nil
+}
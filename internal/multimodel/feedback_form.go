@@ -21,7 +21,7 @@ type FeedbackForm struct {
 	Instructions string `json:"instructions,omitempty"`
 
 	// Form template UID. This form represents a completed form template.
-	BaseTemplateID string `json:"baseTemplate,omitempty"`
+	BaseTemplateID string `json:"baseTemplateId,omitempty"`
 
 	// An array of form fields. Feedback forms support the follow field types:
 	//     - code - for programming questions
@@ -35,7 +35,7 @@ type FeedbackForm struct {
 	//     - text - single line answer
 	//     - textarea - longer form answer
 	//     - yes/no - a yes or no question
-	Forms []any `json:"forms,omitempty"`
+	Forms []any `json:"fields,omitempty"`
 
 	// The user (ID or struct) who completed and submitted the feedback.
 	UserID json.RawMessage `json:"user,omitempty"`
@@ -131,10 +131,10 @@ func unmarshalArrayOfFeedbackFormsOrIDs(raw json.RawMessage) ([]string, []model.
 	}
 
 	// Try unmarshalling as an array of feedback forms first
-	var feedbackForms []model.FeedbackForm
+	var rawFeedbackForms []FeedbackForm
 	var ids []string
 
-	if err := json.Unmarshal(raw, &feedbackForms); err != nil {
+	if err := json.Unmarshal(raw, &rawFeedbackForms); err != nil {
 		// Can't unmarshal as feedback forms; try unmarshalling as IDs.
 		if err := json.Unmarshal(raw, &ids); err != nil {
 			return nil, nil, err
@@ -143,8 +143,13 @@ func unmarshalArrayOfFeedbackFormsOrIDs(raw json.RawMessage) ([]string, []model.
 		return ids, nil, nil
 	}
 
-	for _, feedbackForm := range feedbackForms {
-		ids = append(ids, feedbackForm.ID)
+	feedbackForms := make([]model.FeedbackForm, len(rawFeedbackForms))
+	for i := range rawFeedbackForms {
+		if err := rawFeedbackForms[i].ToModel(&feedbackForms[i]); err != nil {
+			return nil, nil, err
+		}
+
+		ids = append(ids, feedbackForms[i].ID)
 	}
 
 	return ids, feedbackForms, nil
@@ -58,12 +58,8 @@ type Interview struct {
 	// Datetime when interview was canceled. Value is nil if interview was never canceled.
 	CanceledAt *int64 `json:"canceledAt,omitempty"`
 
-	// List of job posting IDs that the interview is associated with
-	PostingIDs []string `json:"postings,omitempty"`
-
-	// List of job postings that the interview is associated with. Returned if expand=postings is
-	// specified.
-	Postings []model.Posting `json:"-"`
+	// List of job postings (IDs or structs) that the interview is associated with
+	Postings json.RawMessage `json:"postings,omitempty"`
 }
 
 // Populate a regular [model.Interview] from this [multimodel.Interview].
@@ -82,8 +78,6 @@ func (o *Interview) ToModel(result *model.Interview) error {
 	result.FeedbackTemplateID = o.FeedbackTemplateID
 	result.FeedbackReminder = o.FeedbackReminder
 	result.CanceledAt = o.CanceledAt
-	result.PostingIDs = o.PostingIDs
-	result.Postings = o.Postings
 
 	feedbackFormIDs, feedbackForms, err := unmarshalArrayOfFeedbackFormsOrIDs(o.FeedbackForms)
 	if err != nil {
@@ -109,5 +103,13 @@ func (o *Interview) ToModel(result *model.Interview) error {
 	result.StageID = stageID
 	result.Stage = stage
 
+	postingIDs, postings, err := unmarshalArrayOfPostingsOrIDs(o.Postings)
+	if err != nil {
+		return err
+	}
+
+	result.PostingIDs = postingIDs
+	result.Postings = postings
+
 	return nil
 }
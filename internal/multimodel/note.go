@@ -0,0 +1,71 @@
+package multimodel
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The Note model, but with expandable fields left unparsed.
+type Note struct {
+	// Note UID
+	ID string `json:"id,omitempty"`
+
+	// Contents of the note.
+	Text string `json:"text,omitempty"`
+
+	// An array of fields in the note.
+	Fields []model.NoteField `json:"fields,omitempty"`
+
+	// If true, the note is only visible to users with access to secret notes.
+	Secret bool `json:"secret,omitempty"`
+
+	// Score attached to the note, if any.
+	Score *int `json:"score,omitempty"`
+
+	// The user (ID or struct) who owns the note.
+	User json.RawMessage `json:"user,omitempty"`
+
+	// The user (ID or struct) who wrote the note.
+	Author json.RawMessage `json:"author,omitempty"`
+
+	// Datetime when note was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Datetime when note was completed.
+	CompletedAt *int64 `json:"completedAt,omitempty"`
+
+	// Datetime when note was deleted.
+	DeletedAt *int64 `json:"deletedAt,omitempty"`
+}
+
+// Populate a regular [model.Note] from this [multimodel.Note].
+func (n *Note) ToModel(result *model.Note) error {
+	// Fields that map 1:1
+	result.ID = n.ID
+	result.Text = n.Text
+	result.Fields = n.Fields
+	result.Secret = n.Secret
+	result.Score = n.Score
+	result.CreatedAt = n.CreatedAt
+	result.CompletedAt = n.CompletedAt
+	result.DeletedAt = n.DeletedAt
+
+	userID, user, err := unmarshalUserOrID(n.User)
+	if err != nil {
+		return err
+	}
+
+	result.UserID = userID
+	result.User = user
+
+	authorID, author, err := unmarshalUserOrID(n.Author)
+	if err != nil {
+		return err
+	}
+
+	result.AuthorID = authorID
+	result.Author = author
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package multimodel
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The Offer model, but with expandable fields left unparsed.
+type Offer struct {
+	// Offer UID
+	ID string `json:"id,omitempty"`
+
+	// Datetime when offer was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Offer status.
+	Status string `json:"status,omitempty"`
+
+	// The user (ID or struct) who created the offer.
+	Creator json.RawMessage `json:"creator,omitempty"`
+
+	// Datetime when offer was approved.
+	ApprovedAt *int64 `json:"approvedAt,omitempty"`
+
+	// Datetime when offer was sent to the candidate.
+	SentAt *int64 `json:"sentAt,omitempty"`
+
+	// The fields on the offer.
+	Fields []model.OfferField `json:"fields,omitempty"`
+
+	// The offer document that was sent to the candidate, if any.
+	SentDocument *model.OfferDocument `json:"sentDocument,omitempty"`
+
+	// The offer document signed by the candidate, if any.
+	SignedDocument *model.OfferDocument `json:"signedDocument,omitempty"`
+}
+
+// Populate a regular [model.Offer] from this [multimodel.Offer].
+func (o *Offer) ToModel(result *model.Offer) error {
+	// Fields that map 1:1
+	result.ID = o.ID
+	result.CreatedAt = o.CreatedAt
+	result.Status = o.Status
+	result.ApprovedAt = o.ApprovedAt
+	result.SentAt = o.SentAt
+	result.Fields = o.Fields
+	result.SentDocument = o.SentDocument
+	result.SignedDocument = o.SignedDocument
+
+	creatorID, creator, err := unmarshalUserOrID(o.Creator)
+	if err != nil {
+		return err
+	}
+
+	result.CreatorID = creatorID
+	result.Creator = creator
+
+	return nil
+}
@@ -18,8 +18,8 @@ type Opportunity struct {
 	// schools that the contact has attended
 	Headline string `json:"headline,omitempty"`
 
-	// Contact UID
-	ContactID string `json:"contact,omitempty"`
+	// The contact (ID or struct) this Opportunity belongs to
+	Contact json.RawMessage `json:"contact,omitempty"`
 
 	// The stage (ID or struct) of this Opportunity's current stage
 	Stage json.RawMessage `json:"stage,omitempty"`
@@ -67,9 +67,9 @@ type Opportunity struct {
 	// An array of users (IDs or structs) of the followers of this Opportunity.
 	Followers json.RawMessage `json:"followers,omitempty"`
 
-	// An array, containing up to one Application ID (can be either an active or archived
-	// Application). Each Opportunity can only have up to one application.
-	ApplicationIDs []string `json:"applications,omitempty"`
+	// An array, containing up to one Application (ID or struct). Each Opportunity can only have up
+	// to one application.
+	Applications json.RawMessage `json:"applications,omitempty"`
 
 	// Datetime when this Opportunity was created in Levermodel. For candidates who applied to a job
 	// posting on your website, the date and time when the Opportunity was created in Lever is the
@@ -138,7 +138,6 @@ func (o *Opportunity) ToModel(result *model.Opportunity) error {
 	result.ID = o.ID
 	result.Name = o.Name
 	result.Headline = o.Headline
-	result.ContactID = o.ContactID
 	result.StageChanges = o.StageChanges
 	result.Confidentiality = o.Confidentiality
 	result.Location = o.Location
@@ -149,7 +148,6 @@ func (o *Opportunity) ToModel(result *model.Opportunity) error {
 	result.Tags = o.Tags
 	result.Sources = o.Sources
 	result.Origin = o.Origin
-	result.ApplicationIDs = o.ApplicationIDs
 	result.CreatedAt = o.CreatedAt
 	result.UpdatedAt = o.UpdatedAt
 	result.LastInteractionAt = o.LastInteractionAt
@@ -162,6 +160,13 @@ func (o *Opportunity) ToModel(result *model.Opportunity) error {
 	result.DeletedAt = o.DeletedAt
 	result.OpportunityLocation = o.OpportunityLocation
 
+	contactID, contact, err := unmarshalContactOrID(o.Contact)
+	if err != nil {
+		return err
+	}
+	result.ContactID = contactID
+	result.Contact = contact
+
 	stageID, stage, err := unmarshalStageOrID(o.Stage)
 	if err != nil {
 		return err
@@ -191,5 +196,12 @@ func (o *Opportunity) ToModel(result *model.Opportunity) error {
 	result.FollowerIDs = followerIDs
 	result.Followers = followers
 
+	applicationIDs, applications, err := unmarshalArrayOfApplicationsOrIDs(o.Applications)
+	if err != nil {
+		return err
+	}
+	result.ApplicationIDs = applicationIDs
+	result.Applications = applications
+
 	return nil
 }
@@ -0,0 +1,96 @@
+package multimodel
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The Panel model, but with expandable fields left unparsed.
+type Panel struct {
+	// Panel UID
+	ID string `json:"id,omitempty"`
+
+	// Array of application IDs the panel is associated with.
+	Applications []string `json:"applications,omitempty"`
+
+	// Datetime when panel was canceled. Value is nil if panel was never canceled.
+	CanceledAt *int64 `json:"canceledAt,omitempty"`
+
+	// Datetime when panel was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Datetime when the first interview in the panel starts.
+	Start *int64 `json:"start,omitempty"`
+
+	// Datetime when the last interview in the panel ends.
+	End *int64 `json:"end,omitempty"`
+
+	// Name of timezone in which panel was scheduled to occur.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Frequency of feedback reminders (i.e. once, daily, frequently, none). Defaults to
+	// 'frequently' which is every 6 hours.
+	FeedbackReminder string `json:"feedbackReminder,omitempty"`
+
+	// The user (ID or struct) who created the panel.
+	User json.RawMessage `json:"user,omitempty"`
+
+	// The stage (ID or struct) in which the candidate resided when this panel was scheduled.
+	Stage json.RawMessage `json:"stage,omitempty"`
+
+	// Panel note
+	Note string `json:"note,omitempty"`
+
+	// A URL linking to the panel in an external scheduling system.
+	ExternalURL string `json:"externalUrl,omitempty"`
+
+	// Whether the panel is managed outside of Lever (i.e. created via the API).
+	ExternallyManaged bool `json:"externallyManaged,omitempty"`
+
+	// The interviews in this panel.
+	Interviews []Interview `json:"interviews,omitempty"`
+}
+
+// Populate a regular [model.Panel] from this [multimodel.Panel].
+func (p *Panel) ToModel(result *model.Panel) error {
+	// Fields that map 1:1
+	result.ID = p.ID
+	result.Applications = p.Applications
+	result.CanceledAt = p.CanceledAt
+	result.CreatedAt = p.CreatedAt
+	result.Start = p.Start
+	result.End = p.End
+	result.Timezone = p.Timezone
+	result.FeedbackReminder = p.FeedbackReminder
+	result.Note = p.Note
+	result.ExternalURL = p.ExternalURL
+	result.ExternallyManaged = p.ExternallyManaged
+
+	userID, user, err := unmarshalUserOrID(p.User)
+	if err != nil {
+		return err
+	}
+
+	result.UserID = userID
+	result.User = user
+
+	stageID, stage, err := unmarshalStageOrID(p.Stage)
+	if err != nil {
+		return err
+	}
+
+	result.StageID = stageID
+	result.Stage = stage
+
+	if p.Interviews != nil {
+		result.Interviews = make([]model.Interview, len(p.Interviews))
+		for i := range p.Interviews {
+			if err := p.Interviews[i].ToModel(&result.Interviews[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package multimodel
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The Posting model, but with expandable fields left unparsed.
+type Posting struct {
+	// Posting UID
+	ID string `json:"id,omitempty"`
+
+	// Title of the job posting
+	Text string `json:"text,omitempty"`
+
+	// Datetime when posting was created in Lever
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Datetime when posting was last updated
+	UpdatedAt *int64 `json:"updatedAt,omitempty"`
+
+	// Posting's current status
+	State string `json:"state,omitempty"`
+
+	// Array of job sites that a published posting appears on.
+	DistributionChannels []string `json:"distributionChannels,omitempty"`
+
+	// The confidentiality of the posting. It is not possible to update a posting’s
+	// confidentiality. Can be one of the following values: non-confidential, confidential.
+	Confidentiality string `json:"confidentiality,omitempty"`
+
+	// The user (ID or struct) who created the posting.
+	User json.RawMessage `json:"user,omitempty"`
+
+	// The user (ID or struct) of the posting owner.
+	Owner json.RawMessage `json:"owner,omitempty"`
+
+	// The user (ID or struct) of the hiring manager for the job posting.
+	HiringManager json.RawMessage `json:"hiringManager,omitempty"`
+
+	// An object containing the tags of various categories.
+	Categories *model.PostingCategories `json:"categories,omitempty"`
+
+	// An array of additional posting tags.
+	Tags []string `json:"tags,omitempty"`
+
+	// Content of the job posting including any custom questions that you've built into the job
+	// application.
+	Content *model.PostingContent `json:"content,omitempty"`
+
+	// An ISO 3166-1 alpha-2 code for a country / territory
+	Country string `json:"country,omitempty"`
+
+	// An array of users (IDs or structs) who are following this posting.
+	Followers json.RawMessage `json:"followers,omitempty"`
+
+	// Requisition code associated with this posting.
+	// WARNING: This field is deprecated but maintained for backwards compatibility.
+	RequisitionCode string `json:"requisitionCode,omitempty"`
+
+	// Array of requisition codes associated with this posting.
+	RequisitionCodes []string `json:"requisitionCodes,omitempty"`
+
+	// An object containing the list, show and apply URLs for the job posting.
+	URLs *model.PostingURLs `json:"urls,omitempty"`
+
+	// Workplace type of this posting. Defaults to 'unspecified'. Can be one of the following
+	// values: onsite, remote, hybrid
+	WorkplaceType string `json:"workplaceType,omitempty"`
+}
+
+// Populate a regular [model.Posting] from this [multimodel.Posting].
+func (p *Posting) ToModel(result *model.Posting) error {
+	// Fields that map 1:1
+	result.ID = p.ID
+	result.Text = p.Text
+	result.CreatedAt = p.CreatedAt
+	result.UpdatedAt = p.UpdatedAt
+	result.State = p.State
+	result.DistributionChannels = p.DistributionChannels
+	result.Confidentiality = p.Confidentiality
+	result.Categories = p.Categories
+	result.Tags = p.Tags
+	result.Content = p.Content
+	result.Country = p.Country
+	result.RequisitionCode = p.RequisitionCode
+	result.RequisitionCodes = p.RequisitionCodes
+	result.URLs = p.URLs
+	result.WorkplaceType = p.WorkplaceType
+
+	userID, user, err := unmarshalUserOrID(p.User)
+	if err != nil {
+		return err
+	}
+	result.UserID = userID
+	result.User = user
+
+	ownerID, owner, err := unmarshalUserOrID(p.Owner)
+	if err != nil {
+		return err
+	}
+	result.OwnerID = ownerID
+	result.Owner = owner
+
+	hiringManagerID, hiringManager, err := unmarshalUserOrID(p.HiringManager)
+	if err != nil {
+		return err
+	}
+	result.HiringManagerID = hiringManagerID
+	result.HiringManager = hiringManager
+
+	followerIDs, followers, err := unmarshalArrayOfUsersOrIDs(p.Followers)
+	if err != nil {
+		return err
+	}
+	result.FollowerIds = followerIDs
+	result.Followers = followers
+
+	return nil
+}
+
+// Unmarshal a posting ID or posting.
+//   - If the raw message is empty or null, returns ("", nil, nil).
+//   - If the raw message is a string, returns (id, nil, nil).
+//   - If the raw message is a posting, returns (posting.ID, &posting, nil).
+func unmarshalPostingOrID(raw json.RawMessage) (string, *model.Posting, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil, nil
+	}
+
+	// Try unmarshalling as a posting first
+	var rawPosting Posting
+	if err := json.Unmarshal(raw, &rawPosting); err != nil {
+		// Can't unmarshal as a posting; try unmarshalling as an ID.
+		var id string
+		if err := json.Unmarshal(raw, &id); err != nil {
+			return "", nil, err
+		}
+
+		return id, nil, nil
+	}
+
+	var posting model.Posting
+	if err := rawPosting.ToModel(&posting); err != nil {
+		return "", nil, err
+	}
+
+	return posting.ID, &posting, nil
+}
+
+// Unmarshal an array of posting IDs or an array of postings.
+//   - If the raw message is empty, returns (nil, nil, nil).
+//   - If the raw message is an array of strings, returns (ids, nil, nil).
+//   - If the raw message is an array of postings, returns (ids, postings, nil).
+func unmarshalArrayOfPostingsOrIDs(raw json.RawMessage) ([]string, []model.Posting, error) {
+	if len(raw) == 0 {
+		return nil, nil, nil
+	}
+
+	var ids []string
+	var rawPostings []Posting
+
+	// Try unmarshalling as an array of postings first
+	if err := json.Unmarshal(raw, &rawPostings); err != nil {
+		// Can't unmarshal as postings; try unmarshalling as IDs.
+		if err := json.Unmarshal(raw, &ids); err != nil {
+			return nil, nil, err
+		}
+
+		return ids, nil, nil
+	}
+
+	postings := make([]model.Posting, len(rawPostings))
+	for i := range rawPostings {
+		if err := rawPostings[i].ToModel(&postings[i]); err != nil {
+			return nil, nil, err
+		}
+
+		ids = append(ids, postings[i].ID)
+	}
+
+	return ids, postings, nil
+}
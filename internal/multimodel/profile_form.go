@@ -0,0 +1,77 @@
+package multimodel
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The ProfileForm model, but with expandable fields left unparsed.
+type ProfileForm struct {
+	// Form UID
+	ID string `json:"id,omitempty"`
+
+	// Form type. Profile forms are of type form.
+	Type string `json:"type,omitempty"`
+
+	// Form title. This can be edited in Feedback and Form Settings.
+	Text string `json:"text,omitempty"`
+
+	// Form instructions.
+	Instructions string `json:"instructions,omitempty"`
+
+	// Form template UID. This form represents a completed profile form template.
+	BaseTemplateID string `json:"baseTemplateId,omitempty"`
+
+	// The form fields, with the values entered for them.
+	Fields []model.CompletedFormField `json:"fields,omitempty"`
+
+	// The user (ID or struct) who completed the form.
+	User json.RawMessage `json:"user,omitempty"`
+
+	// The stage (ID or struct) of the opportunity when the form was completed.
+	Stage json.RawMessage `json:"stage,omitempty"`
+
+	// Datetime when form was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Datetime when form was completed.
+	CompletedAt *int64 `json:"completedAt,omitempty"`
+
+	// Datetime when form was deleted.
+	DeletedAt *int64 `json:"deletedAt,omitempty"`
+}
+
+// Populate a regular [model.ProfileForm] from this [ProfileForm].
+func (f *ProfileForm) ToModel(result *model.ProfileForm) error {
+	// Fields that map 1:1
+	result.ID = f.ID
+	result.Type = f.Type
+	result.Text = f.Text
+	result.Instructions = f.Instructions
+	result.BaseTemplateID = f.BaseTemplateID
+	result.Fields = f.Fields
+	result.CreatedAt = f.CreatedAt
+	result.CompletedAt = f.CompletedAt
+	result.DeletedAt = f.DeletedAt
+
+	// Parse the user field
+	userID, user, err := unmarshalUserOrID(f.User)
+	if err != nil {
+		return err
+	}
+
+	result.UserID = userID
+	result.User = user
+
+	// Parse the stage field
+	stageID, stage, err := unmarshalStageOrID(f.Stage)
+	if err != nil {
+		return err
+	}
+
+	result.StageID = stageID
+	result.Stage = stage
+
+	return nil
+}
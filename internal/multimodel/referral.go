@@ -0,0 +1,85 @@
+package multimodel
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The Referral model, but with expandable fields left unparsed.
+type Referral struct {
+	// Referral UID
+	ID string `json:"id,omitempty"`
+
+	// Form type. Referrals are of type referral.
+	Type string `json:"type,omitempty"`
+
+	// Form title. This can be edited in Feedback and Form Settings.
+	Text string `json:"text,omitempty"`
+
+	// Form instructions.
+	Instructions string `json:"instructions,omitempty"`
+
+	// Form template UID. This referral represents a completed referral form template.
+	BaseTemplateID string `json:"baseTemplateId,omitempty"`
+
+	// The referral form fields, with the values entered by the referrer.
+	Fields []model.CompletedFormField `json:"fields,omitempty"`
+
+	// The user (ID or struct) who made the referral.
+	Referrer json.RawMessage `json:"referrer,omitempty"`
+
+	// The user (ID or struct) who submitted the referral form.
+	User json.RawMessage `json:"user,omitempty"`
+
+	// The stage (ID or struct) of the opportunity when the referral was made.
+	Stage json.RawMessage `json:"stage,omitempty"`
+
+	// Datetime when referral was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Datetime when referral form was completed.
+	CompletedAt *int64 `json:"completedAt,omitempty"`
+}
+
+// Populate a regular [model.Referral] from this [Referral].
+func (r *Referral) ToModel(result *model.Referral) error {
+	// Fields that map 1:1
+	result.ID = r.ID
+	result.Type = r.Type
+	result.Text = r.Text
+	result.Instructions = r.Instructions
+	result.BaseTemplateID = r.BaseTemplateID
+	result.Fields = r.Fields
+	result.CreatedAt = r.CreatedAt
+	result.CompletedAt = r.CompletedAt
+
+	// Parse the referrer field
+	referrerID, referrer, err := unmarshalUserOrID(r.Referrer)
+	if err != nil {
+		return err
+	}
+
+	result.ReferrerID = referrerID
+	result.Referrer = referrer
+
+	// Parse the user field
+	userID, user, err := unmarshalUserOrID(r.User)
+	if err != nil {
+		return err
+	}
+
+	result.UserID = userID
+	result.User = user
+
+	// Parse the stage field
+	stageID, stage, err := unmarshalStageOrID(r.Stage)
+	if err != nil {
+		return err
+	}
+
+	result.StageID = stageID
+	result.Stage = stage
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package multimodel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The value of headcountTotal for requisitions with no limit on hires.
+const headcountUnlimited = "unlimited"
+
+// The Requisition model, but with dynamically typed fields left unparsed.
+type Requisition struct {
+	// Requisition UID
+	ID string `json:"id,omitempty"`
+
+	// The requisition code, usually the identifier of the requisition in an external HRIS.
+	RequisitionCode string `json:"requisitionCode,omitempty"`
+
+	// The human-readable name of the requisition.
+	Name string `json:"name,omitempty"`
+
+	// Whether the requisition is a backfill for an existing employee.
+	Backfill bool `json:"backfill,omitempty"`
+
+	// The confidentiality of the requisition: confidential or non-confidential.
+	Confidentiality string `json:"confidentiality,omitempty"`
+
+	// Datetime when requisition was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// The user ID of the user who created the requisition.
+	CreatorID string `json:"creator,omitempty"`
+
+	// The compensation band for the requisition.
+	CompensationBand *model.RequisitionCompensationBand `json:"compensationBand,omitempty"`
+
+	// The employment status (e.g. full-time, part-time, intern).
+	EmploymentStatus string `json:"employmentStatus,omitempty"`
+
+	// The number of hires that have been made against the requisition.
+	HeadcountHired int `json:"headcountHired,omitempty"`
+
+	// The total number of hires approved for the requisition: either a number or "unlimited".
+	HeadcountTotal json.RawMessage `json:"headcountTotal,omitempty"`
+
+	// The user ID of the hiring manager for the requisition.
+	HiringManagerID string `json:"hiringManager,omitempty"`
+
+	// The user ID of the owner of the requisition.
+	OwnerID string `json:"owner,omitempty"`
+
+	// Internal notes on the requisition.
+	InternalNotes string `json:"internalNotes,omitempty"`
+
+	// The location of the requisition.
+	Location string `json:"location,omitempty"`
+
+	// The department of the requisition.
+	Department string `json:"department,omitempty"`
+
+	// The team of the requisition.
+	Team string `json:"team,omitempty"`
+
+	// The requisition status.
+	Status string `json:"status,omitempty"`
+
+	// IDs of the job postings associated with the requisition.
+	PostingIDs []string `json:"postings,omitempty"`
+
+	// IDs of the offers made against the requisition.
+	OfferIDs []string `json:"offerIds,omitempty"`
+
+	// The approval workflow for the requisition, if any.
+	Approval *model.RequisitionApproval `json:"approval,omitempty"`
+
+	// Values of custom requisition fields, keyed by requisition field key.
+	CustomFields map[string]any `json:"customFields,omitempty"`
+}
+
+// Populate a regular [model.Requisition] from this [multimodel.Requisition].
+func (r *Requisition) ToModel(result *model.Requisition) error {
+	// Fields that map 1:1
+	result.ID = r.ID
+	result.RequisitionCode = r.RequisitionCode
+	result.Name = r.Name
+	result.Backfill = r.Backfill
+	result.Confidentiality = r.Confidentiality
+	result.CreatedAt = r.CreatedAt
+	result.CreatorID = r.CreatorID
+	result.CompensationBand = r.CompensationBand
+	result.EmploymentStatus = r.EmploymentStatus
+	result.HeadcountHired = r.HeadcountHired
+	result.HiringManagerID = r.HiringManagerID
+	result.OwnerID = r.OwnerID
+	result.InternalNotes = r.InternalNotes
+	result.Location = r.Location
+	result.Department = r.Department
+	result.Team = r.Team
+	result.Status = r.Status
+	result.PostingIDs = r.PostingIDs
+	result.OfferIDs = r.OfferIDs
+	result.Approval = r.Approval
+	result.CustomFields = r.CustomFields
+
+	total, unlimited, err := unmarshalHeadcount(r.HeadcountTotal)
+	if err != nil {
+		return err
+	}
+
+	result.HeadcountTotal = total
+	result.HeadcountUnlimited = unlimited
+
+	return nil
+}
+
+// Unmarshal a headcount that is either a number or "unlimited".
+//   - If the raw message is empty, returns (0, false, nil).
+//   - If the raw message is a number, returns (number, false, nil).
+//   - If the raw message is "unlimited", returns (0, true, nil).
+func unmarshalHeadcount(raw json.RawMessage) (int, bool, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0, false, nil
+	}
+
+	var total int
+	if err := json.Unmarshal(raw, &total); err != nil {
+		// Not a number; the only other allowed value is "unlimited".
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return 0, false, err
+		}
+
+		if s != headcountUnlimited {
+			return 0, false, fmt.Errorf("invalid headcount: %q", s)
+		}
+
+		return 0, true, nil
+	}
+
+	return total, false, nil
+}
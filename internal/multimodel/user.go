@@ -7,11 +7,11 @@ import (
 )
 
 // Unmarshal a user ID or user.
-//   - If the raw message is empty, returns ("", nil, nil).
+//   - If the raw message is empty or null, returns ("", nil, nil).
 //   - If the raw message is a string, returns (id, nil, nil).
 //   - If the raw message is a user, returns (user.ID, &user, nil).
 func unmarshalUserOrID(raw json.RawMessage) (string, *model.User, error) {
-	if len(raw) == 0 {
+	if len(raw) == 0 || string(raw) == "null" {
 		return "", nil, nil
 	}
 
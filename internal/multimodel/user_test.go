@@ -15,6 +15,12 @@ func TestEmptyUserUnmarshalling(t *testing.T) {
 		ta.Empty(id)
 		ta.Nil(user)
 	}
+
+	id, user, err = unmarshalUserOrID(json.RawMessage("null"))
+	if ta.NoError(err) {
+		ta.Empty(id)
+		ta.Nil(user)
+	}
 }
 
 func TestUserUnmarshalling(t *testing.T) {
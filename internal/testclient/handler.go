@@ -24,6 +24,9 @@ type ExpectedRequest struct {
 
 	// Query parameters.
 	Query map[string][]string
+
+	// Multipart form values. A key with no values must not be present in the form.
+	FormValues map[string][]string
 }
 
 // Check if the request matches the expected request.
@@ -58,11 +61,26 @@ func (r *ExpectedRequest) IsValid(req *http.Request) error {
 			return err
 		}
 
-		if bytes.Equal(r.Body, body) {
+		if !bytes.Equal(r.Body, body) {
 			return fmt.Errorf("expected body %s, got: %s", string(r.Body), string(body))
 		}
 	}
 
+	if r.FormValues != nil {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			return err
+		}
+
+		for expectedKey, expectedValues := range r.FormValues {
+			expectedFormat := strings.Join(expectedValues, ", ")
+			actualFormat := strings.Join(req.MultipartForm.Value[expectedKey], ", ")
+
+			if expectedFormat != actualFormat {
+				return fmt.Errorf("expected form value %s=%s, got: %s", expectedKey, expectedFormat, actualFormat)
+			}
+		}
+	}
+
 	query := req.URL.Query()
 
 	if r.Query != nil {
@@ -168,6 +186,17 @@ func ExpectQuery(key string, values ...string) func(*ExpectHandler) {
 	}
 }
 
+// Add an expected multipart form value. If no values are given, the form must not contain the key.
+func ExpectFormValue(key string, values ...string) func(*ExpectHandler) {
+	return func(h *ExpectHandler) {
+		if h.Expected.FormValues == nil {
+			h.Expected.FormValues = make(map[string][]string)
+		}
+
+		h.Expected.FormValues[key] = values
+	}
+}
+
 // Expect no query parameters.
 func ExpectNoQuery() func(*ExpectHandler) {
 	return func(h *ExpectHandler) {
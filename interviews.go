@@ -0,0 +1,599 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever interviews client interface
+type InterviewsClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single interview
+	//
+	// This method returns the full interview record for a single interview on an opportunity.
+	GetInterview(ctx context.Context, req *GetInterviewRequest) (*GetInterviewResponse, error)
+
+	// List all interviews
+	//
+	// Lists all interview events for an opportunity.
+	ListInterviews(ctx context.Context, req *ListInterviewsRequest) (*ListInterviewsResponse, error)
+
+	// Create an interview
+	//
+	// Creates an interview on an interview panel. Only panels that are externally managed (i.e.
+	// created via the API) can have interviews added to them.
+	CreateInterview(ctx context.Context, req *CreateInterviewRequest) (*CreateInterviewResponse, error)
+
+	// Update an interview
+	//
+	// Updates an interview on an externally managed interview panel. This replaces the interview
+	// record; all fields must be specified.
+	UpdateInterview(ctx context.Context, req *UpdateInterviewRequest) (*UpdateInterviewResponse, error)
+
+	// Delete an interview
+	//
+	// Deletes an interview on an externally managed interview panel.
+	DeleteInterview(ctx context.Context, req *DeleteInterviewRequest) (*DeleteInterviewResponse, error)
+}
+
+// Returned when attempting to create, update or delete an interview on a panel that Lever manages
+// internally. Only interviews on panels created via the API can be modified via the API.
+var ErrPanelNotExternallyManaged = errors.New("interview panel is not externally managed")
+
+// Parameters for retrieving a single interview.
+type GetInterviewRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The interview id. This is required.
+	InterviewID string
+}
+
+// Create a new GetInterviewRequest with the required fields.
+func NewGetInterviewRequest(opportunityID, interviewID string) *GetInterviewRequest {
+	return &GetInterviewRequest{
+		OpportunityID: opportunityID,
+		InterviewID:   interviewID,
+	}
+}
+
+func (r *GetInterviewRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/interviews/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.InterviewID))
+}
+
+// Response for retrieving a single interview; returned to client users.
+type GetInterviewResponse struct {
+	BaseResponse
+
+	// The interview record.
+	Interview *model.Interview `json:"data"`
+}
+
+// JSON response type for retrieving a single interview, with some field types dynamically determined.
+type getInterviewResponseJSON struct {
+	BaseResponse
+
+	// The interview record.
+	Interview *multimodel.Interview `json:"data"`
+}
+
+// Parameters for listing interviews.
+type ListInterviewsRequest struct {
+	BaseListRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+}
+
+// Create a new ListInterviewsRequest with the required fields.
+func NewListInterviewsRequest(opportunityID string) *ListInterviewsRequest {
+	return &ListInterviewsRequest{
+		OpportunityID: opportunityID,
+	}
+}
+
+func (r *ListInterviewsRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/interviews", url.PathEscape(r.OpportunityID))
+}
+
+// Response for listing interviews; returned to client users.
+type ListInterviewsResponse struct {
+	BaseListResponse
+
+	// The interview records.
+	Interviews []model.Interview `json:"data"`
+}
+
+// JSON response type for listing interviews, with some field types dynamically determined.
+type listInterviewsResponseJSON struct {
+	BaseListResponse
+
+	// The interview records.
+	Interviews []multimodel.Interview `json:"data"`
+}
+
+// Retrieve a single interview
+//
+// This method returns the full interview record for a single interview on an opportunity.
+func (c *Client) GetInterview(ctx context.Context, req *GetInterviewRequest) (*GetInterviewResponse, error) {
+	var respJSON getInterviewResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var interview model.Interview
+	err := respJSON.Interview.ToModel(&interview)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetInterviewResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Interview:    &interview,
+	}
+
+	return &resp, nil
+}
+
+// List all interviews
+//
+// Lists all interview events for an opportunity.
+func (c *Client) ListInterviews(ctx context.Context, req *ListInterviewsRequest) (*ListInterviewsResponse, error) {
+	var respJSON listInterviewsResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	interviews := make([]model.Interview, len(respJSON.Interviews))
+	for i := range respJSON.Interviews {
+		err := respJSON.Interviews[i].ToModel(&interviews[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListInterviewsResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		Interviews:       interviews,
+	}
+
+	return &resp, nil
+}
+
+// Fields common to interview create and update requests.
+type InterviewFields struct {
+	// The interview panel id. This is required.
+	PanelID string
+
+	// Interview subject
+	Subject string
+
+	// Interview note
+	Note string
+
+	// The interviewers. Each interviewer must have an ID; a FeedbackTemplateID may be specified to
+	// use a different feedback form than the interview's. At least one interviewer is required.
+	Interviewers []model.Interviewer
+
+	// Datetime when the interview is scheduled to occur. This is required.
+	Date *int64
+
+	// Interview duration in minutes. This is required.
+	Duration int
+
+	// Interview location. Usually the name of a booked conference room but can also be a phone
+	// number to call.
+	Location string
+
+	// The feedback form template for this interview.
+	FeedbackTemplateID string
+
+	// Frequency of feedback reminders (i.e. once, daily, frequently, none).
+	FeedbackReminder string
+
+	// Name of timezone in which the interview is scheduled to occur.
+	Timezone string
+}
+
+// Check that the required interview fields are present. All problems found are returned, joined
+// with [errors.Join].
+func (f *InterviewFields) Validate() error {
+	var errs []error
+
+	if f.PanelID == "" {
+		errs = append(errs, errors.New("panel id is required"))
+	}
+
+	errs = append(errs, validateInterviewSchedule(f.Interviewers, f.Date, f.Duration)...)
+
+	return errors.Join(errs...)
+}
+
+// Check the interviewers, date and duration of an interview, returning all problems found.
+func validateInterviewSchedule(interviewers []model.Interviewer, date *int64, duration int) []error {
+	var errs []error
+
+	if len(interviewers) == 0 {
+		errs = append(errs, errors.New("at least one interviewer is required"))
+	}
+
+	for i, interviewer := range interviewers {
+		if interviewer.ID == "" {
+			errs = append(errs, fmt.Errorf("interviewer %d: id is required", i))
+		}
+	}
+
+	if date == nil {
+		errs = append(errs, errors.New("date is required"))
+	}
+
+	if duration <= 0 {
+		errs = append(errs, errors.New("duration must be positive"))
+	}
+
+	return errs
+}
+
+// JSON body for the interview create and update requests.
+type interviewRequestBody struct {
+	PanelID            string                   `json:"panel,omitempty"`
+	Subject            string                   `json:"subject,omitempty"`
+	Note               string                   `json:"note,omitempty"`
+	Interviewers       []interviewerRequestBody `json:"interviewers"`
+	Date               *int64                   `json:"date,omitempty"`
+	Duration           int                      `json:"duration,omitempty"`
+	Location           string                   `json:"location,omitempty"`
+	FeedbackTemplateID string                   `json:"feedbackTemplate,omitempty"`
+	FeedbackReminder   string                   `json:"feedbackReminder,omitempty"`
+	Timezone           string                   `json:"timezone,omitempty"`
+}
+
+// JSON body for an interviewer in the interview create and update requests. Lever only accepts
+// the id and feedback template here.
+type interviewerRequestBody struct {
+	ID                 string `json:"id"`
+	FeedbackTemplateID string `json:"feedbackTemplate,omitempty"`
+}
+
+// Convert interviewers to their request body representation.
+func newInterviewerRequestBodies(interviewers []model.Interviewer) []interviewerRequestBody {
+	result := make([]interviewerRequestBody, len(interviewers))
+	for i, interviewer := range interviewers {
+		result[i] = interviewerRequestBody{
+			ID:                 interviewer.ID,
+			FeedbackTemplateID: interviewer.FeedbackTemplateID,
+		}
+	}
+
+	return result
+}
+
+// Encode the interview fields as a JSON request body.
+func (f *InterviewFields) encode() (io.Reader, error) {
+	body := interviewRequestBody{
+		PanelID:            f.PanelID,
+		Subject:            f.Subject,
+		Note:               f.Note,
+		Interviewers:       newInterviewerRequestBodies(f.Interviewers),
+		Date:               f.Date,
+		Duration:           f.Duration,
+		Location:           f.Location,
+		FeedbackTemplateID: f.FeedbackTemplateID,
+		FeedbackReminder:   f.FeedbackReminder,
+		Timezone:           f.Timezone,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Parameters for creating an interview.
+type CreateInterviewRequest struct {
+	BaseRequest
+	InterviewFields
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// Perform this create on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// Skip checking that the panel is externally managed before creating the interview. By
+	// default, the panel is retrieved first so that [ErrPanelNotExternallyManaged] can be
+	// returned instead of a less descriptive API error.
+	SkipPanelCheck bool
+}
+
+// Create a new CreateInterviewRequest with the required fields.
+func NewCreateInterviewRequest(performAsID, opportunityID, panelID string) *CreateInterviewRequest {
+	return &CreateInterviewRequest{
+		InterviewFields: InterviewFields{
+			PanelID: panelID,
+		},
+		OpportunityID: opportunityID,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *CreateInterviewRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/interviews", url.PathEscape(r.OpportunityID))
+}
+
+func (r *CreateInterviewRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreateInterviewRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *CreateInterviewRequest) GetBody() (io.Reader, error) {
+	return r.InterviewFields.encode()
+}
+
+// Response for creating an interview; returned to client users.
+type CreateInterviewResponse struct {
+	BaseResponse
+
+	// The interview record.
+	Interview *model.Interview `json:"data"`
+}
+
+// JSON response type for creating an interview, with some field types dynamically determined.
+type createInterviewResponseJSON struct {
+	BaseResponse
+
+	// The interview record.
+	Interview *multimodel.Interview `json:"data"`
+}
+
+// Parameters for updating an interview.
+type UpdateInterviewRequest struct {
+	BaseRequest
+	InterviewFields
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The interview id. This is required.
+	InterviewID string
+
+	// Perform this update on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// Skip checking that the panel is externally managed before updating the interview.
+	SkipPanelCheck bool
+}
+
+// Create a new UpdateInterviewRequest with the required fields.
+func NewUpdateInterviewRequest(performAsID, opportunityID, interviewID, panelID string) *UpdateInterviewRequest {
+	return &UpdateInterviewRequest{
+		InterviewFields: InterviewFields{
+			PanelID: panelID,
+		},
+		OpportunityID: opportunityID,
+		InterviewID:   interviewID,
+		PerformAsID:   performAsID,
+	}
+}
+
+// Create a new UpdateInterviewRequest based on an existing Interview struct.
+func NewUpdateInterviewRequestFromInterview(performAsID, opportunityID string, interview *model.Interview) *UpdateInterviewRequest {
+	return &UpdateInterviewRequest{
+		InterviewFields: InterviewFields{
+			PanelID:            interview.PanelID,
+			Subject:            interview.Subject,
+			Note:               interview.Note,
+			Interviewers:       interview.Interviewers,
+			Date:               interview.Date,
+			Duration:           interview.Duration,
+			Location:           interview.Location,
+			FeedbackTemplateID: interview.FeedbackTemplateID,
+			FeedbackReminder:   interview.FeedbackReminder,
+			Timezone:           interview.Timezone,
+		},
+		OpportunityID: opportunityID,
+		InterviewID:   interview.ID,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *UpdateInterviewRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/interviews/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.InterviewID))
+}
+
+func (r *UpdateInterviewRequest) GetHTTPMethod() string {
+	return http.MethodPut
+}
+
+func (r *UpdateInterviewRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *UpdateInterviewRequest) GetBody() (io.Reader, error) {
+	return r.InterviewFields.encode()
+}
+
+// Response for updating an interview; returned to client users.
+type UpdateInterviewResponse struct {
+	BaseResponse
+
+	// The interview record.
+	Interview *model.Interview `json:"data"`
+}
+
+// JSON response type for updating an interview, with some field types dynamically determined.
+type updateInterviewResponseJSON struct {
+	BaseResponse
+
+	// The interview record.
+	Interview *multimodel.Interview `json:"data"`
+}
+
+// Parameters for deleting an interview.
+type DeleteInterviewRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The interview id. This is required.
+	InterviewID string
+
+	// Perform this delete on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// The interview panel id. This is optional; if not specified and SkipPanelCheck is false, the
+	// interview is retrieved to determine its panel.
+	PanelID string
+
+	// Skip checking that the panel is externally managed before deleting the interview.
+	SkipPanelCheck bool
+}
+
+// Create a new DeleteInterviewRequest with the required fields.
+func NewDeleteInterviewRequest(performAsID, opportunityID, interviewID string) *DeleteInterviewRequest {
+	return &DeleteInterviewRequest{
+		OpportunityID: opportunityID,
+		InterviewID:   interviewID,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *DeleteInterviewRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/interviews/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.InterviewID))
+}
+
+func (r *DeleteInterviewRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+func (r *DeleteInterviewRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+// Response for deleting an interview.
+type DeleteInterviewResponse struct {
+	BaseResponse
+}
+
+// Create an interview
+//
+// Creates an interview on an interview panel. Only panels that are externally managed (i.e.
+// created via the API) can have interviews added to them.
+func (c *Client) CreateInterview(ctx context.Context, req *CreateInterviewRequest) (*CreateInterviewResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !req.SkipPanelCheck {
+		if err := c.checkPanelExternallyManaged(ctx, req.OpportunityID, req.PanelID); err != nil {
+			return nil, err
+		}
+	}
+
+	var respJSON createInterviewResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var interview model.Interview
+	err := respJSON.Interview.ToModel(&interview)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := CreateInterviewResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Interview:    &interview,
+	}
+
+	return &resp, nil
+}
+
+// Update an interview
+//
+// Updates an interview on an externally managed interview panel. This replaces the interview
+// record; all fields must be specified.
+func (c *Client) UpdateInterview(ctx context.Context, req *UpdateInterviewRequest) (*UpdateInterviewResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !req.SkipPanelCheck {
+		if err := c.checkPanelExternallyManaged(ctx, req.OpportunityID, req.PanelID); err != nil {
+			return nil, err
+		}
+	}
+
+	var respJSON updateInterviewResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var interview model.Interview
+	err := respJSON.Interview.ToModel(&interview)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := UpdateInterviewResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Interview:    &interview,
+	}
+
+	return &resp, nil
+}
+
+// Delete an interview
+//
+// Deletes an interview on an externally managed interview panel.
+func (c *Client) DeleteInterview(ctx context.Context, req *DeleteInterviewRequest) (*DeleteInterviewResponse, error) {
+	if !req.SkipPanelCheck {
+		panelID := req.PanelID
+		if panelID == "" {
+			getResp, err := c.GetInterview(ctx, NewGetInterviewRequest(req.OpportunityID, req.InterviewID))
+			if err != nil {
+				return nil, err
+			}
+
+			panelID = getResp.Interview.PanelID
+		}
+
+		if err := c.checkPanelExternallyManaged(ctx, req.OpportunityID, panelID); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp DeleteInterviewResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,355 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterviews(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{interviewOnSite}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/interviews"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": []map[string]any{expandInterview(interviewOnSite, "user", "stage", "postings", "feedbackForms")}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/interviews"),
+			testclient.ExpectQuery("expand", "user", "stage", "postings", "feedbackForms"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": expandInterview(interviewOnSite, "postings")}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/interviews/3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6"),
+			testclient.ExpectQuery("expand", "postings"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Interview was not found"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/interviews/00000000-0000-0000-0000-000000000000"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// List interviews
+	listReq := NewListInterviewsRequest("250d8f03-738a-4bba-a671-8a3d73477145")
+	listResp, err := c.ListInterviews(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.Interviews, 1) {
+		interview := listResp.Interviews[0]
+		ta.Equal("3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6", interview.ID)
+		ta.Equal("On-site interview", interview.Subject)
+		ta.Len(interview.Interviewers, 1)
+		ta.Equal(60, interview.Duration)
+		ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", interview.UserID)
+		ta.Nil(interview.User)
+		ta.Equal("00922a60-7c15-422b-b086-f62000824fd7", interview.StageID)
+		ta.Nil(interview.Stage)
+		ta.Equal([]string{"f2f01e16-27f8-4711-a728-7d49499795a0"}, interview.PostingIDs)
+		ta.Empty(interview.Postings)
+		ta.Equal([]string{"b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40"}, interview.FeedbackFormIDs)
+		ta.Empty(interview.FeedbackForms)
+	}
+
+	// List interviews with all fields expanded
+	listReq.Expand = []string{"user", "stage", "postings", "feedbackForms"}
+	listResp, err = c.ListInterviews(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.Interviews, 1) {
+		interview := listResp.Interviews[0]
+		if ta.NotNil(interview.User) {
+			ta.Equal(interview.UserID, interview.User.ID)
+		}
+		if ta.NotNil(interview.Stage) {
+			ta.Equal(interview.StageID, interview.Stage.ID)
+		}
+		if ta.Len(interview.Postings, 1) {
+			ta.Equal(interview.PostingIDs[0], interview.Postings[0].ID)
+			ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", interview.Postings[0].OwnerID)
+		}
+		if ta.Len(interview.FeedbackForms, 1) {
+			ta.Equal(interview.FeedbackFormIDs[0], interview.FeedbackForms[0].ID)
+			ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", interview.FeedbackForms[0].UserID)
+			ta.Equal("3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6", interview.FeedbackForms[0].InterviewID)
+		}
+	}
+
+	// Get an interview
+	getReq := NewGetInterviewRequest("250d8f03-738a-4bba-a671-8a3d73477145", "3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6")
+	getReq.Expand = []string{"postings"}
+	getResp, err := c.GetInterview(ctx, getReq)
+
+	if ta.NoError(err) && ta.NotNil(getResp.Interview) {
+		ta.Equal("3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6", getResp.Interview.ID)
+		ta.Len(getResp.Interview.Postings, 1)
+		ta.Nil(getResp.Interview.User)
+	}
+
+	// Get an interview that does not exist
+	getReq = NewGetInterviewRequest("250d8f03-738a-4bba-a671-8a3d73477145", "00000000-0000-0000-0000-000000000000")
+	getResp, err = c.GetInterview(ctx, getReq)
+
+	if ta.Error(err) {
+		ta.Nil(getResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+}
+
+func TestCreateUpdateDeleteInterview(t *testing.T) {
+	ta := assert.New(t)
+
+	const opportunityPath = "/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145"
+	const panelPath = opportunityPath + "/panels/8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0"
+	const interviewPath = opportunityPath + "/interviews/3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6"
+	const performAsID = "df0adaa6-172c-4cd6-8520-49b203660fe1"
+
+	createBody := `{"panel":"8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0","subject":"On-site interview","interviewers":[{"id":"ecdb6670-d9f3-4b87-8267-1cde26d1bc42","feedbackTemplate":"d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2"}],"date":1423587600000,"duration":60,"location":"Conference room A","feedbackReminder":"frequently","timezone":"America/Los_Angeles"}` + "\n"
+	updateBody := `{"panel":"8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0","subject":"On-site interview","note":"Please bring a laptop.","interviewers":[{"id":"ecdb6670-d9f3-4b87-8267-1cde26d1bc42"}],"date":1423587600000,"duration":90,"location":"Conference room A","feedbackTemplate":"d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2","feedbackReminder":"frequently","timezone":"America/Los_Angeles"}` + "\n"
+
+	updated := expandInterview(interviewOnSite)
+	updated["duration"] = 90
+
+	s := testclient.NewExpectManyHandler(
+		// Create an interview
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			`{"data":{"id":"8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0","externallyManaged":true}}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(panelPath),
+		),
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": interviewOnSite}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath(opportunityPath+"/interviews"),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectBody(createBody),
+		),
+
+		// Update an interview
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			`{"data":{"id":"8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0","externallyManaged":true}}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(panelPath),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": updated}),
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath(interviewPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectBody(updateBody),
+		),
+
+		// Update an interview on a panel managed by Lever
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			`{"data":{"id":"8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0","externallyManaged":false}}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(panelPath),
+		),
+
+		// Delete an interview without specifying the panel
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": interviewOnSite}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(interviewPath),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			`{"data":{"id":"8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0","externallyManaged":true}}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(panelPath),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath(interviewPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+		),
+
+		// Delete an interview, skipping the panel check
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath(interviewPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Create an interview
+	date := int64(1423587600000)
+	createReq := NewCreateInterviewRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0")
+	createReq.Subject = "On-site interview"
+	createReq.Interviewers = []model.Interviewer{
+		{ID: "ecdb6670-d9f3-4b87-8267-1cde26d1bc42", Name: "Rachel Green", FeedbackTemplateID: "d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2"},
+	}
+	createReq.Date = &date
+	createReq.Duration = 60
+	createReq.Location = "Conference room A"
+	createReq.FeedbackReminder = "frequently"
+	createReq.Timezone = "America/Los_Angeles"
+	createResp, err := c.CreateInterview(ctx, createReq)
+
+	if ta.NoError(err) && ta.NotNil(createResp.Interview) {
+		ta.Equal("3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6", createResp.Interview.ID)
+		ta.Equal("8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0", createResp.Interview.PanelID)
+	}
+
+	// Update an interview
+	updateReq := NewUpdateInterviewRequestFromInterview(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", createResp.Interview)
+	updateReq.Duration = 90
+	updateResp, err := c.UpdateInterview(ctx, updateReq)
+
+	if ta.NoError(err) && ta.NotNil(updateResp.Interview) {
+		ta.Equal(90, updateResp.Interview.Duration)
+	}
+
+	// Update an interview on a panel managed by Lever
+	updateResp, err = c.UpdateInterview(ctx, updateReq)
+	ta.Nil(updateResp)
+	ta.ErrorIs(err, ErrPanelNotExternallyManaged)
+
+	// Invalid requests are rejected before anything is sent
+	invalidReq := NewCreateInterviewRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "")
+	createResp, err = c.CreateInterview(ctx, invalidReq)
+	if ta.Error(err) {
+		ta.Nil(createResp)
+		ta.ErrorContains(err, "panel id is required")
+		ta.ErrorContains(err, "at least one interviewer is required")
+		ta.ErrorContains(err, "date is required")
+		ta.ErrorContains(err, "duration must be positive")
+	}
+
+	// Delete an interview without specifying the panel
+	deleteReq := NewDeleteInterviewRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6")
+	_, err = c.DeleteInterview(ctx, deleteReq)
+	ta.NoError(err)
+
+	// Delete an interview, skipping the panel check
+	deleteReq.SkipPanelCheck = true
+	_, err = c.DeleteInterview(ctx, deleteReq)
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+// expandInterview expands the specified fields in the interview data.
+func expandInterview(orig map[string]any, fields ...string) map[string]any {
+	expanded := make(map[string]any)
+	for k, v := range orig {
+		expanded[k] = v
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "user":
+			expanded["user"] = expandUser(expanded["user"].(string))
+
+		case "stage":
+			expanded["stage"] = expandStage(expanded["stage"].(string))
+
+		case "postings":
+			postings := make([]map[string]any, 0)
+			for _, postingID := range expanded["postings"].([]string) {
+				if postingID != postingCustomerSuccess["id"] {
+					panic("No posting found for ID " + postingID)
+				}
+				postings = append(postings, postingCustomerSuccess)
+			}
+			expanded["postings"] = postings
+
+		case "feedbackForms":
+			feedbackForms := make([]map[string]any, 0)
+			for _, feedbackFormID := range expanded["feedbackForms"].([]string) {
+				if feedbackFormID != feedbackOnSite["id"] {
+					panic("No feedback form found for ID " + feedbackFormID)
+				}
+				feedbackForms = append(feedbackForms, feedbackOnSite)
+			}
+			expanded["feedbackForms"] = feedbackForms
+		}
+	}
+
+	return expanded
+}
+
+var interviewOnSite = map[string]any{
+	"id":      "3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6",
+	"panel":   "8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0",
+	"subject": "On-site interview",
+	"note":    "Please bring a laptop.",
+	"interviewers": []map[string]any{
+		{
+			"id":    "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+			"name":  "Rachel Green",
+			"email": "rachel@example.com",
+		},
+	},
+	"timezone":         "America/Los_Angeles",
+	"createdAt":        1423187881576,
+	"date":             1423587600000,
+	"duration":         60,
+	"location":         "Conference room A",
+	"feedbackTemplate": "d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2",
+	"feedbackForms":    []string{"b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40"},
+	"feedbackReminder": "frequently",
+	"user":             "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"stage":            "00922a60-7c15-422b-b086-f62000824fd7",
+	"canceledAt":       nil,
+	"postings":         []string{"f2f01e16-27f8-4711-a728-7d49499795a0"},
+}
+
+var feedbackOnSite = map[string]any{
+	"id":             "b3e0c4d2-5a4e-4a5b-9c39-0b5d8d8e1f40",
+	"type":           "interview",
+	"text":           "On-site interview feedback",
+	"instructions":   "Evaluate the candidate's problem solving skills.",
+	"baseTemplateId": "d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2",
+	"fields": []map[string]any{
+		{
+			"type":        "score-system",
+			"text":        "Rating",
+			"description": "",
+			"required":    true,
+			"value":       3,
+		},
+	},
+	"user":        "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+	"panel":       "8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0",
+	"interview":   "3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6",
+	"createdAt":   1423587600000,
+	"completedAt": 1423597600000,
+}
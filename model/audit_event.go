@@ -0,0 +1,124 @@
+package model
+
+import "encoding/json"
+
+// Audit event types with typed metadata. Events of other types are still returned, with their
+// metadata available only in [AuditEvent.RawMetadata].
+const (
+	AuditEventTypeAPICredentialCreated  = "api_credential.created"
+	AuditEventTypeAPICredentialDeleted  = "api_credential.deleted"
+	AuditEventTypeDataExportCreated     = "data_export.created"
+	AuditEventTypeUserAccessRoleUpdated = "user.access_role.updated"
+	AuditEventTypeUserCreated           = "user.created"
+	AuditEventTypeUserDeactivated       = "user.deactivated"
+	AuditEventTypeUserLoginFailed       = "user.login.failed"
+	AuditEventTypeUserLoginSucceeded    = "user.login.succeeded"
+	AuditEventTypeUserReactivated       = "user.reactivated"
+)
+
+// Audit events record administrative activity in a Lever account, such as users being created or
+// deactivated, access roles changing and API credentials being issued.
+type AuditEvent struct {
+	// Audit event UID
+	ID string
+
+	// The event type (e.g. user.created). See the AuditEventType constants for types with typed
+	// metadata.
+	Type string
+
+	// The user who performed the action, if the action was performed by a user.
+	Actor *AuditEventActor
+
+	// The object the action was performed on.
+	Target *AuditEventTarget
+
+	// Datetime when the event occurred.
+	CreatedAt *int64
+
+	// Metadata specific to the event type, decoded into the matching AuditEventXxxMetadata struct
+	// (as a pointer) for known event types. Nil for unknown event types, for events without
+	// metadata, and for metadata that does not match the struct for its event type.
+	Metadata any
+
+	// The metadata exactly as returned by Lever. This is always set when the event has metadata,
+	// including for unknown event types.
+	RawMetadata json.RawMessage
+}
+
+// The user who performed an audited action.
+type AuditEventActor struct {
+	// User UID
+	ID string `json:"id,omitempty"`
+
+	// User's preferred name
+	Name string `json:"name,omitempty"`
+
+	// User's email address
+	Email string `json:"email,omitempty"`
+}
+
+// The object an audited action was performed on.
+type AuditEventTarget struct {
+	// The UID of the target object.
+	ID string `json:"id,omitempty"`
+
+	// The type of the target object (e.g. user, api_credential).
+	Type string `json:"type,omitempty"`
+
+	// A human-readable label for the target object (e.g. the user's name).
+	Label string `json:"label,omitempty"`
+}
+
+// Metadata for user.created events.
+type AuditEventUserCreatedMetadata struct {
+	// The access role given to the new user.
+	AccessRole string `json:"accessRole,omitempty"`
+}
+
+// Metadata for user.deactivated and user.reactivated events.
+type AuditEventUserStatusMetadata struct {
+	// The reason given for the change, if any.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Metadata for user.access_role.updated events.
+type AuditEventUserAccessRoleMetadata struct {
+	// The user's access role before the change.
+	PreviousAccessRole string `json:"previousAccessRole,omitempty"`
+
+	// The user's access role after the change.
+	AccessRole string `json:"accessRole,omitempty"`
+}
+
+// Metadata for user.login.succeeded and user.login.failed events.
+type AuditEventLoginMetadata struct {
+	// The IP address the login came from.
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// The user agent of the browser or client used to log in.
+	UserAgent string `json:"userAgent,omitempty"`
+
+	// The login method (e.g. password, sso, google).
+	Method string `json:"method,omitempty"`
+
+	// The reason the login failed. Only set for user.login.failed events.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// Metadata for api_credential.created and api_credential.deleted events.
+type AuditEventAPICredentialMetadata struct {
+	// The name given to the credential.
+	Name string `json:"name,omitempty"`
+
+	// The API permissions granted to the credential.
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// Metadata for data_export.created events.
+type AuditEventDataExportMetadata struct {
+	// The kind of data exported (e.g. candidates, postings).
+	ExportType string `json:"exportType,omitempty"`
+
+	// The number of records in the export.
+	RecordCount int `json:"recordCount,omitempty"`
+}
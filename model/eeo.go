@@ -0,0 +1,97 @@
+package model
+
+// EEO gender responses.
+const (
+	EEOGenderFemale                = "Female"
+	EEOGenderMale                  = "Male"
+	EEOGenderDeclineToSelfIdentify = "Decline to self-identify"
+)
+
+// EEO race responses.
+const (
+	EEORaceAmericanIndianOrAlaskaNative         = "American Indian or Alaskan Native (Not Hispanic or Latino)"
+	EEORaceAsian                                = "Asian (Not Hispanic or Latino)"
+	EEORaceBlackOrAfricanAmerican               = "Black or African American (Not Hispanic or Latino)"
+	EEORaceHispanicOrLatino                     = "Hispanic or Latino"
+	EEORaceNativeHawaiianOrOtherPacificIslander = "Native Hawaiian or Other Pacific Islander (Not Hispanic or Latino)"
+	EEORaceTwoOrMoreRaces                       = "Two or More Races (Not Hispanic or Latino)"
+	EEORaceWhite                                = "White (Not Hispanic or Latino)"
+	EEORaceDeclineToSelfIdentify                = "Decline to self-identify"
+)
+
+// EEO veteran status responses.
+const (
+	EEOVeteranProtected             = "I am a Protected Veteran"
+	EEOVeteranNotProtected          = "I am not a Protected Veteran"
+	EEOVeteranDeclineToSelfIdentify = "Decline to self-identify"
+)
+
+// EEO disability status responses.
+const (
+	EEODisabilityYes             = "Yes, I have a disability, or have a history/record of having a disability"
+	EEODisabilityNo              = "No, I don't have a disability, or a history/record of having a disability"
+	EEODisabilityDeclineToAnswer = "I don't wish to answer"
+)
+
+// A candidate's answers to the EEO questions on a job application, as returned by the EEO
+// responses API and submitted when applying to a posting. Fields are empty if the candidate did
+// not answer the question.
+type EEOResponses struct {
+	// Gender. One of the EEOGender constants.
+	Gender string `json:"gender,omitempty"`
+
+	// Race. One of the EEORace constants.
+	Race string `json:"race,omitempty"`
+
+	// Veteran status. One of the EEOVeteran constants.
+	Veteran string `json:"veteran,omitempty"`
+
+	// Disability status. One of the EEODisability constants.
+	Disability string `json:"disability,omitempty"`
+
+	// The name the candidate signed the disability self-identification form with.
+	DisabilitySignature string `json:"disabilitySignature,omitempty"`
+
+	// The date the candidate signed the disability self-identification form, as entered by the
+	// candidate (e.g. "05/15/2023").
+	DisabilitySignatureDate string `json:"disabilitySignatureDate,omitempty"`
+}
+
+// De-identified EEO responses for a single application. These cannot be linked to the candidate.
+type EEOResponse struct {
+	// The candidate's EEO answers.
+	Responses EEOResponses `json:"eeoResponses"`
+
+	// Datetime when the application was submitted.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// The posting ID the candidate applied to.
+	PostingID string `json:"posting,omitempty"`
+
+	// The ID of the opportunity's current stage.
+	CurrentStageID string `json:"currentStage,omitempty"`
+
+	// The ID of the reason the opportunity was archived, if it is archived.
+	ArchiveReasonID string `json:"archiveReason,omitempty"`
+
+	// Datetime when the opportunity was archived, if it is archived.
+	ArchivedAt *int64 `json:"archivedAt,omitempty"`
+}
+
+// EEO responses for a single application, linked to the candidate's opportunity. Access to these
+// responses requires the EEO PII scope.
+type EEOResponsePII struct {
+	EEOResponse
+
+	// The opportunity ID the responses belong to.
+	OpportunityID string `json:"opportunityId,omitempty"`
+
+	// The contact ID of the candidate.
+	ContactID string `json:"contactId,omitempty"`
+
+	// The candidate's name.
+	Name string `json:"name,omitempty"`
+
+	// The candidate's email addresses.
+	Emails []string `json:"emails,omitempty"`
+}
@@ -60,3 +60,6 @@ type FeedbackForm struct {
 	// Datetime when form was deleted.
 	DeletedAt *int64
 }
+
+// A value for a single field when creating or updating a feedback form.
+type FeedbackFieldValue = FormFieldValue
@@ -0,0 +1,46 @@
+package model
+
+// Feedback templates define the fields on the feedback forms that interviewers complete. Each
+// feedback form is based on a feedback template.
+type FeedbackTemplate struct {
+	// Template UID
+	ID string `json:"id,omitempty"`
+
+	// Template title.
+	Text string `json:"text,omitempty"`
+
+	// Template instructions.
+	Instructions string `json:"instructions,omitempty"`
+
+	// The group the template belongs to, if any.
+	Group *FeedbackTemplateGroup `json:"group,omitempty"`
+
+	// Datetime when template was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Datetime when template was last updated.
+	UpdatedAt *int64 `json:"updatedAt,omitempty"`
+
+	// The fields in the template. Feedback templates support the following field types:
+	//     - code - for programming questions
+	//     - date - special field for dates
+	//     - dropdown - a dropdown menu
+	//     - multiple-choice - choose only one
+	//     - multiple-select - choose 1 or more
+	//     - score-system - overall candidate rating
+	//     - score - thumbs up / thumbs down format
+	//     - scorecard - customized evaluation for multiple skills
+	//     - text - single line answer
+	//     - textarea - longer form answer
+	//     - yes-no - a yes or no question
+	Fields []FormField `json:"fields,omitempty"`
+}
+
+// The group a feedback template belongs to.
+type FeedbackTemplateGroup struct {
+	// Group UID
+	ID string `json:"id,omitempty"`
+
+	// Group name
+	Name string `json:"name,omitempty"`
+}
@@ -13,3 +13,41 @@ type Reader struct {
 	// The MIME type for the file.
 	MIMEType string
 }
+
+// Metadata for a file attached to an opportunity.
+type File struct {
+	// File UID
+	ID string `json:"id,omitempty"`
+
+	// File download URL
+	DownloadURL string `json:"downloadUrl,omitempty"`
+
+	// File extension
+	Ext string `json:"ext,omitempty"`
+
+	// File name
+	Name string `json:"name,omitempty"`
+
+	// Datetime when file was uploaded in Lever
+	UploadedAt *int64 `json:"uploadedAt,omitempty"`
+
+	// The status of processing the file. Can be one of the following values: processing,
+	// processed, unsupported, error, or null.
+	Status string `json:"status,omitempty"`
+
+	// The size of the file in bytes.
+	Size *int64 `json:"size,omitempty"`
+}
+
+// A file uploaded to Lever's temporary storage. The URI can be referenced by later requests
+// instead of sending the file contents again, until the upload expires.
+type Upload struct {
+	// The URI of the uploaded file.
+	URI string `json:"uri,omitempty"`
+
+	// The name of the uploaded file.
+	Filename string `json:"filename,omitempty"`
+
+	// Datetime when the upload expires and can no longer be referenced.
+	ExpiresAt *int64 `json:"expiresAt,omitempty"`
+}
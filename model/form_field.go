@@ -0,0 +1,166 @@
+package model
+
+import "strconv"
+
+// Form field types.
+const (
+	FormFieldTypeCode           = "code"
+	FormFieldTypeCurrency       = "currency"
+	FormFieldTypeDate           = "date"
+	FormFieldTypeDropdown       = "dropdown"
+	FormFieldTypeFile           = "file"
+	FormFieldTypeMultipleChoice = "multiple-choice"
+	FormFieldTypeMultipleSelect = "multiple-select"
+	FormFieldTypeScore          = "score"
+	FormFieldTypeScorecard      = "scorecard"
+	FormFieldTypeScoreSystem    = "score-system"
+	FormFieldTypeText           = "text"
+	FormFieldTypeTextarea       = "textarea"
+	FormFieldTypeYesNo          = "yes-no"
+)
+
+// A single field in a form, such as a posting application question or a feedback template
+// field.
+type FormField struct {
+	// Field UID, if the field has one.
+	ID string `json:"id,omitempty"`
+
+	// Field type. One of the FormFieldType constants.
+	Type string `json:"type,omitempty"`
+
+	// Field name, used to identify fields that do not have a UID (e.g. "name", "email", "resume").
+	Name string `json:"name,omitempty"`
+
+	// Field title, as displayed to the person completing the form.
+	Text string `json:"text,omitempty"`
+
+	// Field description.
+	Description string `json:"description,omitempty"`
+
+	// Whether a value for this field is required.
+	Required bool `json:"required,omitempty"`
+
+	// The options for dropdown, multiple-choice and multiple-select fields.
+	Options []FormFieldOption `json:"options,omitempty"`
+
+	// The rating scale for score, score-system and scorecard fields.
+	Scores []FormFieldScore `json:"scores,omitempty"`
+
+	// The skills rated on a scorecard field.
+	Skills []FormFieldSkill `json:"skills,omitempty"`
+}
+
+// An option for a dropdown, multiple-choice or multiple-select field.
+type FormFieldOption struct {
+	// Option text.
+	Text string `json:"text,omitempty"`
+}
+
+// A point on the rating scale of a score, score-system or scorecard field.
+type FormFieldScore struct {
+	// Score label (e.g. "Strong Hire").
+	Text string `json:"text,omitempty"`
+
+	// Score description.
+	Description string `json:"description,omitempty"`
+}
+
+// A skill rated on a scorecard field.
+type FormFieldSkill struct {
+	// Skill name.
+	Text string `json:"text,omitempty"`
+
+	// Skill description.
+	Description string `json:"description,omitempty"`
+}
+
+// Returns true if the field restricts its values to the values in Options.
+func (f *FormField) HasOptions() bool {
+	switch f.Type {
+	case FormFieldTypeDropdown, FormFieldTypeMultipleChoice, FormFieldTypeMultipleSelect:
+		return len(f.Options) > 0
+	}
+
+	return false
+}
+
+// Returns true if the given value is one of the options for this field.
+func (f *FormField) IsOption(value string) bool {
+	for _, option := range f.Options {
+		if option.Text == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// An answer to a single form field.
+type FormFieldAnswer struct {
+	// The answer for single-valued fields (text, textarea, dropdown, multiple-choice, yes-no, date,
+	// etc.).
+	Value string
+
+	// The answers for multiple-select fields.
+	Values []string
+}
+
+// Returns true if no value has been provided for this answer.
+func (a *FormFieldAnswer) IsEmpty() bool {
+	return a.Value == "" && len(a.Values) == 0
+}
+
+// A form field together with the value entered for it, as returned on completed forms such as
+// referrals and profile forms.
+type CompletedFormField struct {
+	FormField
+
+	// The value entered for the field. The type depends on the field type: a string for text,
+	// textarea, code, dropdown, multiple-choice and yes-no fields; a number for score, score-system
+	// and date fields; and an array for multiple-select and scorecard fields. Nil if the field was
+	// left blank.
+	Value any `json:"value,omitempty"`
+}
+
+// Returns the value of the field as a [FormFieldAnswer]. Numbers are formatted in decimal, and
+// arrays of strings are returned in Values. Values of other types (e.g. scorecard ratings) are
+// not converted and produce an empty answer.
+func (f *CompletedFormField) Answer() FormFieldAnswer {
+	switch value := f.Value.(type) {
+	case string:
+		return FormFieldAnswer{Value: value}
+
+	case float64:
+		return FormFieldAnswer{Value: strconv.FormatFloat(value, 'f', -1, 64)}
+
+	case []string:
+		return FormFieldAnswer{Values: value}
+
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			text, ok := item.(string)
+			if !ok {
+				return FormFieldAnswer{}
+			}
+
+			values = append(values, text)
+		}
+
+		return FormFieldAnswer{Values: values}
+	}
+
+	return FormFieldAnswer{}
+}
+
+// A value for a single field when creating or updating a completed form, such as a feedback form
+// or a profile form.
+type FormFieldValue struct {
+	// The field UID from the form template.
+	ID string `json:"id"`
+
+	// The field value. The type depends on the field type: a string for text, textarea, code,
+	// dropdown, multiple-choice and yes-no fields; a number for score and score-system fields; a
+	// timestamp for date fields; and an array for multiple-select and scorecard fields.
+	Value any `json:"value"`
+}
@@ -82,4 +82,8 @@ type Interviewer struct {
 
 	// User's email
 	Email string `json:"email,omitempty"`
+
+	// The feedback form template this interviewer should use, if different from the interview's
+	// feedback template.
+	FeedbackTemplateID string `json:"feedbackTemplate,omitempty"`
 }
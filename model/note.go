@@ -0,0 +1,55 @@
+package model
+
+// Notes are free-form comments left on an opportunity by users. A note may be a reply to another
+// note, forming a thread.
+type Note struct {
+	// Note UID
+	ID string
+
+	// Contents of the note.
+	Text string
+
+	// An array of fields in the note. Notes created in the Lever application contain a single
+	// field of type note holding the note text.
+	Fields []NoteField
+
+	// If true, the note is only visible to users with access to secret notes.
+	Secret bool
+
+	// Score attached to the note, if any.
+	Score *int
+
+	// The user ID of the note's owner.
+	UserID string
+
+	// The user who owns the note. Returned if expand=user is specified.
+	User *User
+
+	// The user ID of the note's author. This differs from UserID when the note was created on
+	// behalf of another user.
+	AuthorID string
+
+	// The user who wrote the note. Returned if expand=author is specified.
+	Author *User
+
+	// Datetime when note was created.
+	CreatedAt *int64
+
+	// Datetime when note was completed.
+	CompletedAt *int64
+
+	// Datetime when note was deleted. Value is nil if note has not been deleted.
+	DeletedAt *int64
+}
+
+// A field in a note.
+type NoteField struct {
+	// Field type (e.g. note, score).
+	Type string `json:"type,omitempty"`
+
+	// Field title.
+	Text string `json:"text,omitempty"`
+
+	// Field value. For note fields this is the note text.
+	Value any `json:"value,omitempty"`
+}
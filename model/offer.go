@@ -0,0 +1,166 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// Offer statuses.
+const (
+	OfferStatusDraft        = "draft"
+	OfferStatusApprovalSent = "approval-sent"
+	OfferStatusApproved     = "approved"
+	OfferStatusSent         = "sent"
+	OfferStatusSentManually = "sent-manually"
+	OfferStatusOpened       = "opened"
+	OfferStatusDenied       = "denied"
+	OfferStatusSigned       = "signed"
+)
+
+// Identifiers of standard offer fields. Custom offer fields have account-specific identifiers.
+const (
+	OfferFieldAnticipatedStartDate = "anticipated_start_date"
+	OfferFieldCompensationBand     = "compensation_band"
+	OfferFieldHiringManager        = "hiring_manager"
+	OfferFieldJobTitle             = "job_title"
+	OfferFieldSalaryAmount         = "salary_amount"
+	OfferFieldSalaryCurrency       = "salary_currency"
+	OfferFieldSalaryInterval       = "salary_interval"
+)
+
+// Offers are created for an opportunity once a candidate is ready to be made an offer. Offers
+// move through an approval process, are sent to the candidate and may be signed electronically.
+type Offer struct {
+	// Offer UID
+	ID string
+
+	// Datetime when offer was created.
+	CreatedAt *int64
+
+	// Offer status. One of the OfferStatus constants.
+	Status string
+
+	// The user ID of the user who created the offer.
+	CreatorID string
+
+	// The user who created the offer. Returned if expand=creator is specified.
+	Creator *User
+
+	// Datetime when offer was approved. Value is nil if the offer has not been approved.
+	ApprovedAt *int64
+
+	// Datetime when offer was sent to the candidate. Value is nil if the offer has not been sent.
+	SentAt *int64
+
+	// The fields on the offer, including standard fields (salary, start date, etc.) and custom
+	// fields.
+	Fields []OfferField
+
+	// The offer document that was sent to the candidate, if any.
+	SentDocument *OfferDocument
+
+	// The offer document signed by the candidate, if any.
+	SignedDocument *OfferDocument
+}
+
+// Returns the offer field with the given identifier, or nil if the offer has no such field.
+func (o *Offer) Field(identifier string) *OfferField {
+	for i := range o.Fields {
+		if o.Fields[i].Identifier == identifier {
+			return &o.Fields[i]
+		}
+	}
+
+	return nil
+}
+
+// Returns the salary amount, if the offer has one.
+func (o *Offer) SalaryAmount() (float64, bool) {
+	return o.Field(OfferFieldSalaryAmount).NumberValue()
+}
+
+// Returns the salary currency (e.g. "USD"), if the offer has one.
+func (o *Offer) SalaryCurrency() (string, bool) {
+	return o.Field(OfferFieldSalaryCurrency).StringValue()
+}
+
+// Returns the salary interval (e.g. "per-year-salary"), if the offer has one.
+func (o *Offer) SalaryInterval() (string, bool) {
+	return o.Field(OfferFieldSalaryInterval).StringValue()
+}
+
+// Returns the job title, if the offer has one.
+func (o *Offer) JobTitle() (string, bool) {
+	return o.Field(OfferFieldJobTitle).StringValue()
+}
+
+// Returns the anticipated start date, if the offer has one.
+func (o *Offer) StartDate() (time.Time, bool) {
+	return o.Field(OfferFieldAnticipatedStartDate).DateValue()
+}
+
+// A field on an offer.
+type OfferField struct {
+	// Field title (e.g. "Salary").
+	Text string `json:"text,omitempty"`
+
+	// Field identifier. Standard fields use the OfferField constants.
+	Identifier string `json:"identifier,omitempty"`
+
+	// Field value. Dates are timestamps, amounts are numbers and most other fields are strings.
+	// Use StringValue, NumberValue or DateValue to read it as a specific type.
+	Value any `json:"value,omitempty"`
+}
+
+// Returns the value of a text field. Returns false if the field is nil or its value is not a
+// string.
+func (f *OfferField) StringValue() (string, bool) {
+	if f == nil {
+		return "", false
+	}
+
+	value, ok := f.Value.(string)
+	return value, ok
+}
+
+// Returns the value of a number field, such as an amount. Numbers sent as strings are parsed.
+// Returns false if the field is nil or its value is not a number.
+func (f *OfferField) NumberValue() (float64, bool) {
+	if f == nil {
+		return 0, false
+	}
+
+	switch value := f.Value.(type) {
+	case float64:
+		return value, true
+
+	case string:
+		number, err := strconv.ParseFloat(value, 64)
+		return number, err == nil
+	}
+
+	return 0, false
+}
+
+// Returns the value of a date field, which Lever sends as a timestamp in milliseconds since the
+// Unix epoch. Returns false if the field is nil or its value is not a timestamp.
+func (f *OfferField) DateValue() (time.Time, bool) {
+	millis, ok := f.NumberValue()
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return time.UnixMilli(int64(millis)), true
+}
+
+// An offer document.
+type OfferDocument struct {
+	// Document file name
+	FileName string `json:"fileName,omitempty"`
+
+	// Datetime when document was uploaded.
+	UploadedAt *int64 `json:"uploadedAt,omitempty"`
+
+	// Document download URL
+	DownloadURL string `json:"downloadUrl,omitempty"`
+}
@@ -55,6 +55,9 @@ type Opportunity struct {
 	// Contact UID
 	ContactID string
 
+	// The contact this Opportunity belongs to. Returned if expand=contact is specified.
+	Contact *Contact
+
 	// The stage ID of this Opportunity's current stage
 	StageID string
 
@@ -118,6 +121,11 @@ type Opportunity struct {
 	// Application). Each Opportunity can only have up to one application.
 	ApplicationIDs []string
 
+	// An array containing up to one Application. Returned if expand=applications is specified. The
+	// application's posting, postingOwner and postingHiringManager are also populated if they are
+	// expanded.
+	Applications []Application
+
 	// Datetime when this Opportunity was created in Lever. For candidates who applied to a job
 	// posting on your website, the date and time when the Opportunity was created in Lever is the
 	// moment when the candidate clicked the "Apply" button on their application.
@@ -0,0 +1,57 @@
+package model
+
+// Interview panels group one or more interviews that are scheduled for an opportunity, such as an
+// onsite loop. Panels created via the API are externally managed; only externally managed panels
+// (and their interviews) can be updated or deleted via the API.
+type Panel struct {
+	// Panel UID
+	ID string
+
+	// Array of application IDs the panel is associated with.
+	Applications []string
+
+	// Datetime when panel was canceled. Value is nil if panel was never canceled.
+	CanceledAt *int64
+
+	// Datetime when panel was created.
+	CreatedAt *int64
+
+	// Datetime when the first interview in the panel starts.
+	Start *int64
+
+	// Datetime when the last interview in the panel ends.
+	End *int64
+
+	// Name of timezone in which panel was scheduled to occur.
+	Timezone string
+
+	// Frequency of feedback reminders (i.e. once, daily, frequently, none). Defaults to
+	// 'frequently' which is every 6 hours.
+	FeedbackReminder string
+
+	// The user ID who created the panel.
+	UserID string
+
+	// The user who created the panel. Returned if expand=user is specified.
+	User *User
+
+	// The stage ID in which the candidate resided when this panel was scheduled.
+	StageID string
+
+	// The stage in which the candidate resided when this panel was scheduled. Returned if
+	// expand=stage is specified.
+	Stage *Stage
+
+	// Panel note
+	Note string
+
+	// A URL linking to the panel in an external scheduling system.
+	ExternalURL string
+
+	// Whether the panel is managed outside of Lever (i.e. created via the API). Only externally
+	// managed panels can be updated or deleted via the API.
+	ExternallyManaged bool
+
+	// The interviews in this panel.
+	Interviews []Interview
+}
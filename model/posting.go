@@ -141,13 +141,7 @@ type PostingContent struct {
 	DescriptionHtml string `json:"descriptionHtml,omitempty"`
 
 	// Lists of requirements, responsibilities, etc. that have been added to this posting
-	Lists []struct {
-		// Title of the list.
-		Title string `json:"title,omitempty"`
-
-		// Content of the list, as styled HTML.
-		Content string `json:"content,omitempty"`
-	} `json:"lists,omitempty"`
+	Lists []PostingContentList `json:"lists,omitempty"`
 
 	// Closing statement on job posting, as plaintext.
 	Closing string `json:"closing,omitempty"`
@@ -156,6 +150,15 @@ type PostingContent struct {
 	ClosingHtml string `json:"closingHtml,omitempty"`
 }
 
+// A list of requirements, responsibilities, etc. that has been added to a posting.
+type PostingContentList struct {
+	// Title of the list.
+	Title string `json:"text,omitempty"`
+
+	// Content of the list, as styled HTML.
+	Content string `json:"content,omitempty"`
+}
+
 // An object containing the list, show and apply urls for the job posting.
 //
 // TODO: Determine whether this struct should be shared with other models.
@@ -0,0 +1,81 @@
+package model
+
+// The application form for a job posting. This describes the questions a candidate must answer
+// when applying to the posting, including personal information, links, custom questions and
+// EEO questions.
+type PostingForm struct {
+	// Personal information fields (e.g. name, email, phone, resume).
+	PersonalInformation []FormField `json:"personalInformation,omitempty"`
+
+	// Link fields (e.g. LinkedIn, GitHub, portfolio).
+	URLs []FormField `json:"urls,omitempty"`
+
+	// Custom questions that have been built into the job application.
+	CustomQuestions []PostingFormCustomQuestion `json:"customQuestions,omitempty"`
+
+	// EEO questions, if EEO questions are enabled for the posting.
+	EEOQuestions *PostingFormEEOQuestions `json:"eeoQuestions,omitempty"`
+}
+
+// A custom question set on a posting application form.
+type PostingFormCustomQuestion struct {
+	// Custom question UID.
+	ID string `json:"id,omitempty"`
+
+	// Custom question title.
+	Text string `json:"text,omitempty"`
+
+	// Custom question description.
+	Description string `json:"description,omitempty"`
+
+	// The fields in the custom question.
+	Fields []FormField `json:"fields,omitempty"`
+}
+
+// The EEO questions on a posting application form.
+type PostingFormEEOQuestions struct {
+	// Gender question.
+	Gender *FormField `json:"gender,omitempty"`
+
+	// Race question.
+	Race *FormField `json:"race,omitempty"`
+
+	// Veteran status question.
+	Veteran *FormField `json:"veteran,omitempty"`
+
+	// Disability status question.
+	Disability *FormField `json:"disability,omitempty"`
+
+	// Disability signature question.
+	DisabilitySignature *FormField `json:"disabilitySignature,omitempty"`
+
+	// Disability signature date question.
+	DisabilitySignatureDate *FormField `json:"disabilitySignatureDate,omitempty"`
+}
+
+// A named value for a personal information or link field on a posting application form.
+type PostingFormNamedAnswer struct {
+	// The field name (e.g. "name", "email", "LinkedIn").
+	Name string
+
+	// The field value.
+	Value string
+}
+
+// The answers to a custom question on a posting application form.
+type PostingFormCustomAnswer struct {
+	// Custom question UID.
+	ID string
+
+	// Answers to the fields in the custom question, in the same order as the fields.
+	Fields []FormFieldAnswer
+}
+
+// The answers to the EEO questions on a posting application form.
+type PostingFormEEOAnswers = EEOResponses
+
+// The result of applying to a posting.
+type PostingApplicationResult struct {
+	// Application UID
+	ApplicationID string `json:"applicationId,omitempty"`
+}
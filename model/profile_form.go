@@ -0,0 +1,57 @@
+package model
+
+// Profile forms are completed by users to record structured information about a candidate on an
+// opportunity, such as compensation expectations or visa status. Each profile form is based on a
+// profile form template.
+type ProfileForm struct {
+	// Form UID
+	ID string
+
+	// Form type. Profile forms are of type form.
+	Type string
+
+	// Form title. This can be edited in Feedback and Form Settings.
+	Text string
+
+	// Form instructions.
+	Instructions string
+
+	// Form template UID. This form represents a completed profile form template.
+	BaseTemplateID string
+
+	// The form fields, with the values entered for them.
+	Fields []CompletedFormField
+
+	// The user ID of the user who completed the form.
+	UserID string
+
+	// The user who completed the form. Returned if expand=user is specified.
+	User *User
+
+	// The stage ID of the opportunity's stage when the form was completed.
+	StageID string
+
+	// The stage of the opportunity when the form was completed. Returned if expand=stage is
+	// specified.
+	Stage *Stage
+
+	// Datetime when form was created.
+	CreatedAt *int64
+
+	// Datetime when form was completed.
+	CompletedAt *int64
+
+	// Datetime when form was deleted.
+	DeletedAt *int64
+}
+
+// Returns the field with the given text, or nil if the form has no such field.
+func (f *ProfileForm) Field(text string) *CompletedFormField {
+	for i := range f.Fields {
+		if f.Fields[i].Text == text {
+			return &f.Fields[i]
+		}
+	}
+
+	return nil
+}
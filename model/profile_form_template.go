@@ -0,0 +1,32 @@
+package model
+
+// Profile form templates define the fields on the profile forms that users complete on
+// opportunities. Each profile form is based on a profile form template.
+type ProfileFormTemplate struct {
+	// Template UID
+	ID string `json:"id,omitempty"`
+
+	// Template title.
+	Text string `json:"text,omitempty"`
+
+	// Template instructions.
+	Instructions string `json:"instructions,omitempty"`
+
+	// Datetime when template was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Datetime when template was last updated.
+	UpdatedAt *int64 `json:"updatedAt,omitempty"`
+
+	// The fields in the template. Profile form templates support the following field types:
+	//     - currency - a monetary amount
+	//     - date - special field for dates
+	//     - dropdown - a dropdown menu
+	//     - multiple-choice - choose only one
+	//     - multiple-select - choose 1 or more
+	//     - score-system - overall candidate rating
+	//     - text - single line answer
+	//     - textarea - longer form answer
+	//     - yes-no - a yes or no question
+	Fields []FormField `json:"fields,omitempty"`
+}
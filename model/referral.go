@@ -0,0 +1,61 @@
+package model
+
+// Referrals are forms completed by a user at your company when they refer a candidate. A referral
+// records who made the referral, when, and their answers to the referral form.
+type Referral struct {
+	// Referral UID
+	ID string
+
+	// Form type. Referrals are of type referral.
+	Type string
+
+	// Form title. This can be edited in Feedback and Form Settings.
+	Text string
+
+	// Form instructions.
+	Instructions string
+
+	// Form template UID. This referral represents a completed referral form template.
+	BaseTemplateID string
+
+	// The referral form fields, with the values entered by the referrer.
+	Fields []CompletedFormField
+
+	// The user ID of the user who made the referral.
+	ReferrerID string
+
+	// The user who made the referral. Returned if expand=referrer is specified.
+	Referrer *User
+
+	// The user ID of the user who submitted the referral form. This is usually the same as the
+	// referrer, but may differ if the referral was submitted on the referrer's behalf.
+	UserID string
+
+	// The user who submitted the referral form. Returned if expand=user is specified.
+	User *User
+
+	// The stage ID of the opportunity's stage when the referral was made.
+	StageID string
+
+	// The stage of the opportunity when the referral was made. Returned if expand=stage is
+	// specified.
+	Stage *Stage
+
+	// Datetime when referral was created.
+	CreatedAt *int64
+
+	// Datetime when referral form was completed.
+	CompletedAt *int64
+}
+
+// Returns the field with the given text (e.g. "Relationship"), or nil if the referral form has no
+// such field.
+func (r *Referral) Field(text string) *CompletedFormField {
+	for i := range r.Fields {
+		if r.Fields[i].Text == text {
+			return &r.Fields[i]
+		}
+	}
+
+	return nil
+}
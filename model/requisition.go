@@ -0,0 +1,143 @@
+package model
+
+// Requisition statuses.
+const (
+	RequisitionStatusOpen   = "open"
+	RequisitionStatusOnHold = "onHold"
+	RequisitionStatusClosed = "closed"
+	RequisitionStatusDraft  = "draft"
+)
+
+// Requisitions track approved headcount. A requisition may be associated with one or more job
+// postings, and hires are made against a requisition.
+type Requisition struct {
+	// Requisition UID
+	ID string
+
+	// The requisition code, usually the identifier of the requisition in an external HRIS.
+	RequisitionCode string
+
+	// The human-readable name of the requisition.
+	Name string
+
+	// Whether the requisition is a backfill for an existing employee.
+	Backfill bool
+
+	// The confidentiality of the requisition: confidential or non-confidential.
+	Confidentiality string
+
+	// Datetime when requisition was created.
+	CreatedAt *int64
+
+	// The user ID of the user who created the requisition.
+	CreatorID string
+
+	// The compensation band for the requisition.
+	CompensationBand *RequisitionCompensationBand
+
+	// The employment status (e.g. full-time, part-time, intern).
+	EmploymentStatus string
+
+	// The number of hires that have been made against the requisition.
+	HeadcountHired int
+
+	// The total number of hires approved for the requisition. Zero if HeadcountUnlimited is set.
+	HeadcountTotal int
+
+	// Whether an unlimited number of hires can be made against the requisition.
+	HeadcountUnlimited bool
+
+	// The user ID of the hiring manager for the requisition.
+	HiringManagerID string
+
+	// The user ID of the owner of the requisition.
+	OwnerID string
+
+	// Internal notes on the requisition.
+	InternalNotes string
+
+	// The location of the requisition.
+	Location string
+
+	// The department of the requisition.
+	Department string
+
+	// The team of the requisition.
+	Team string
+
+	// The requisition status. One of the RequisitionStatus constants.
+	Status string
+
+	// IDs of the job postings associated with the requisition.
+	PostingIDs []string
+
+	// IDs of the offers made against the requisition.
+	OfferIDs []string
+
+	// The approval workflow for the requisition, if any.
+	Approval *RequisitionApproval
+
+	// Values of custom requisition fields, keyed by requisition field key. See the requisition
+	// fields endpoint for the field definitions.
+	CustomFields map[string]any
+}
+
+// The compensation band for a requisition.
+type RequisitionCompensationBand struct {
+	// ISO 4217 currency code (e.g. USD).
+	Currency string `json:"currency,omitempty"`
+
+	// The pay interval (e.g. per-year-salary, per-hour-wage).
+	Interval string `json:"interval,omitempty"`
+
+	// Minimum compensation.
+	Min *float64 `json:"min,omitempty"`
+
+	// Maximum compensation.
+	Max *float64 `json:"max,omitempty"`
+}
+
+// The approval workflow for a requisition.
+type RequisitionApproval struct {
+	// Approval UID
+	ID string `json:"id,omitempty"`
+
+	// Approval status (e.g. pending, approved, rejected).
+	Status string `json:"status,omitempty"`
+
+	// The user ID of the user who started the approval.
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// Datetime when approval was started.
+	StartedAt *int64 `json:"startedAt,omitempty"`
+
+	// Datetime when approval was completed. Value is nil if approval is not complete.
+	ApprovedAt *int64 `json:"approvedAt,omitempty"`
+
+	// The steps in the approval workflow.
+	Steps []RequisitionApprovalStep `json:"steps,omitempty"`
+}
+
+// A step in a requisition approval workflow.
+type RequisitionApprovalStep struct {
+	// Step status.
+	Status string `json:"status,omitempty"`
+
+	// The number of approvals required to complete the step.
+	ApprovalsRequired int `json:"approvalsRequired,omitempty"`
+
+	// The approvers for the step.
+	Approvers []RequisitionApprover `json:"approvers,omitempty"`
+}
+
+// An approver in a requisition approval step.
+type RequisitionApprover struct {
+	// The user ID of the approver.
+	ID string `json:"id,omitempty"`
+
+	// Approval status for this approver.
+	Status string `json:"status,omitempty"`
+
+	// Datetime when this approver approved. Value is nil if not approved.
+	ApprovedAt *int64 `json:"approvedAt,omitempty"`
+}
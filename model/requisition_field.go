@@ -0,0 +1,68 @@
+package model
+
+// Requisition field types.
+const (
+	RequisitionFieldTypeDate     = "date"
+	RequisitionFieldTypeDropdown = "dropdown"
+	RequisitionFieldTypeNumber   = "number"
+	RequisitionFieldTypeObject   = "object"
+	RequisitionFieldTypeText     = "text"
+)
+
+// Requisition fields define the custom fields that can be set on requisitions across the
+// account. Values for these fields appear in [Requisition.CustomFields], keyed by field ID.
+type RequisitionField struct {
+	// Field key, used as the key in [Requisition.CustomFields]. This is chosen when the field is
+	// created.
+	ID string `json:"id,omitempty"`
+
+	// Field name, as displayed in the Lever application.
+	Text string `json:"text,omitempty"`
+
+	// Field type. One of the RequisitionFieldType constants.
+	Type string `json:"type,omitempty"`
+
+	// Whether a value for this field is required on every requisition.
+	IsRequired bool `json:"isRequired,omitempty"`
+
+	// The options for dropdown fields.
+	Options []RequisitionFieldOption `json:"options,omitempty"`
+
+	// The subfields of object fields. The value of an object field is a map keyed by subfield ID.
+	// Subfields cannot themselves be object fields.
+	Subfields []RequisitionField `json:"subfields,omitempty"`
+
+	// Datetime when field was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+}
+
+// An option for a dropdown requisition field.
+type RequisitionFieldOption struct {
+	// Option UID
+	ID string `json:"id,omitempty"`
+
+	// Option text. This is the value stored in [Requisition.CustomFields].
+	Text string `json:"text,omitempty"`
+}
+
+// Returns the option with the given text, or nil if the field has no such option.
+func (f *RequisitionField) Option(text string) *RequisitionFieldOption {
+	for i := range f.Options {
+		if f.Options[i].Text == text {
+			return &f.Options[i]
+		}
+	}
+
+	return nil
+}
+
+// Returns the subfield with the given ID, or nil if the field has no such subfield.
+func (f *RequisitionField) Subfield(id string) *RequisitionField {
+	for i := range f.Subfields {
+		if f.Subfields[i].ID == id {
+			return &f.Subfields[i]
+		}
+	}
+
+	return nil
+}
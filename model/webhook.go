@@ -0,0 +1,60 @@
+package model
+
+// Webhook event types.
+const (
+	WebhookEventApplicationCreated     = "applicationCreated"
+	WebhookEventCandidateArchiveChange = "candidateArchiveChange"
+	WebhookEventCandidateDeleted       = "candidateDeleted"
+	WebhookEventCandidateHired         = "candidateHired"
+	WebhookEventCandidateStageChange   = "candidateStageChange"
+	WebhookEventContactCreated         = "contactCreated"
+	WebhookEventContactUpdated         = "contactUpdated"
+	WebhookEventInterviewCreated       = "interviewCreated"
+	WebhookEventInterviewDeleted       = "interviewDeleted"
+	WebhookEventInterviewUpdated       = "interviewUpdated"
+)
+
+// Webhooks notify an external URL when an event occurs in Lever. Each webhook is configured for a
+// single event type.
+type Webhook struct {
+	// Webhook UID
+	ID string `json:"id,omitempty"`
+
+	// The event type that triggers the webhook. One of the WebhookEvent constants.
+	Event string `json:"event,omitempty"`
+
+	// The URL the webhook delivers events to.
+	URL string `json:"url,omitempty"`
+
+	// Whether the webhook is enabled.
+	Enabled bool `json:"enabled"`
+
+	// Additional configuration for the webhook.
+	Configuration *WebhookConfiguration `json:"configuration,omitempty"`
+
+	// The token used to sign deliveries of this webhook. Deliveries include a signature computed
+	// from this token, which receivers use to verify that the delivery came from Lever.
+	SignatureToken string `json:"signatureToken,omitempty"`
+
+	// Datetime when webhook was created.
+	CreatedAt *int64 `json:"createdAt,omitempty"`
+
+	// Datetime when webhook was last updated.
+	UpdatedAt *int64 `json:"updatedAt,omitempty"`
+}
+
+// Additional configuration for a webhook.
+type WebhookConfiguration struct {
+	// Conditions an event must meet for the webhook to be triggered.
+	Conditions *WebhookConditions `json:"conditions,omitempty"`
+}
+
+// Conditions an event must meet for a webhook to be triggered. Empty conditions match all events.
+type WebhookConditions struct {
+	// Only trigger for opportunities with one of these origins (e.g. applied, sourced, referred).
+	Origins []string `json:"origins,omitempty"`
+
+	// Only trigger for opportunities with this confidentiality: confidential, non-confidential or
+	// all.
+	Confidentiality string `json:"confidentiality,omitempty"`
+}
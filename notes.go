@@ -0,0 +1,343 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever notes client interface
+type NotesClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single note
+	//
+	// This method returns the full note record for a single note on an opportunity.
+	GetNote(ctx context.Context, req *GetNoteRequest) (*GetNoteResponse, error)
+
+	// List all notes
+	//
+	// Lists all notes for an opportunity.
+	ListNotes(ctx context.Context, req *ListNotesRequest) (*ListNotesResponse, error)
+
+	// Create a note
+	//
+	// Creates a note on an opportunity, optionally as a reply to an existing note thread.
+	CreateNote(ctx context.Context, req *CreateNoteRequest) (*CreateNoteResponse, error)
+
+	// Delete a note
+	//
+	// Deletes a note from an opportunity. Only notes created via the API can be deleted.
+	DeleteNote(ctx context.Context, req *DeleteNoteRequest) (*DeleteNoteResponse, error)
+}
+
+// Parameters for retrieving a single note.
+type GetNoteRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The note id. This is required.
+	NoteID string
+}
+
+// Create a new GetNoteRequest with the required fields.
+func NewGetNoteRequest(opportunityID, noteID string) *GetNoteRequest {
+	return &GetNoteRequest{
+		OpportunityID: opportunityID,
+		NoteID:        noteID,
+	}
+}
+
+func (r *GetNoteRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/notes/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.NoteID))
+}
+
+// Response for retrieving a single note; returned to client users.
+type GetNoteResponse struct {
+	BaseResponse
+
+	// The note record.
+	Note *model.Note `json:"data"`
+}
+
+// JSON response type for retrieving a single note, with some field types dynamically determined.
+type getNoteResponseJSON struct {
+	BaseResponse
+
+	// The note record.
+	Note *multimodel.Note `json:"data"`
+}
+
+// Parameters for listing notes.
+type ListNotesRequest struct {
+	BaseListRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+}
+
+// Create a new ListNotesRequest with the required fields.
+func NewListNotesRequest(opportunityID string) *ListNotesRequest {
+	return &ListNotesRequest{
+		OpportunityID: opportunityID,
+	}
+}
+
+func (r *ListNotesRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/notes", url.PathEscape(r.OpportunityID))
+}
+
+// Response for listing notes; returned to client users.
+type ListNotesResponse struct {
+	BaseListResponse
+
+	// The note records.
+	Notes []model.Note `json:"data"`
+}
+
+// JSON response type for listing notes, with some field types dynamically determined.
+type listNotesResponseJSON struct {
+	BaseListResponse
+
+	// The note records.
+	Notes []multimodel.Note `json:"data"`
+}
+
+// Parameters for creating a note.
+type CreateNoteRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// Perform this create on behalf of a specified user. The note will be attributed to this user.
+	// This is required.
+	PerformAsID string
+
+	// The id of an existing note. If specified, the new note is added as a reply in that note's
+	// thread. This is optional.
+	ReplyToNoteID string
+
+	// The body of the note. This is required.
+	Value string
+
+	// If true, the note is only visible to users with access to secret notes.
+	Secret bool
+
+	// Score to attach to the note. This is optional.
+	Score *int
+
+	// If true, followers of the opportunity are notified of the note.
+	NotifyFollowers bool
+
+	// Datetime when the note was created. Defaults to the current time.
+	CreatedAt *int64
+}
+
+// Create a new CreateNoteRequest with the required fields.
+func NewCreateNoteRequest(performAsID, opportunityID, value string) *CreateNoteRequest {
+	return &CreateNoteRequest{
+		OpportunityID: opportunityID,
+		PerformAsID:   performAsID,
+		Value:         value,
+	}
+}
+
+func (r *CreateNoteRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/notes", url.PathEscape(r.OpportunityID))
+}
+
+func (r *CreateNoteRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreateNoteRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+
+	if r.ReplyToNoteID != "" {
+		query.Add(paramNoteID, r.ReplyToNoteID)
+	}
+}
+
+// JSON body for the note create request.
+type createNoteRequestBody struct {
+	Value           string `json:"value"`
+	Secret          bool   `json:"secret,omitempty"`
+	Score           *int   `json:"score,omitempty"`
+	NotifyFollowers bool   `json:"notifyFollowers,omitempty"`
+	CreatedAt       *int64 `json:"createdAt,omitempty"`
+}
+
+func (r *CreateNoteRequest) GetBody() (io.Reader, error) {
+	body := createNoteRequestBody{
+		Value:           r.Value,
+		Secret:          r.Secret,
+		Score:           r.Score,
+		NotifyFollowers: r.NotifyFollowers,
+		CreatedAt:       r.CreatedAt,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Response for creating a note; returned to client users.
+type CreateNoteResponse struct {
+	BaseResponse
+
+	// The note record.
+	Note *model.Note `json:"data"`
+}
+
+// JSON response type for creating a note, with some field types dynamically determined.
+type createNoteResponseJSON struct {
+	BaseResponse
+
+	// The note record.
+	Note *multimodel.Note `json:"data"`
+}
+
+// Parameters for deleting a note.
+type DeleteNoteRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The note id. This is required.
+	NoteID string
+
+	// Perform this delete on behalf of a specified user. This is required.
+	PerformAsID string
+}
+
+// Create a new DeleteNoteRequest with the required fields.
+func NewDeleteNoteRequest(performAsID, opportunityID, noteID string) *DeleteNoteRequest {
+	return &DeleteNoteRequest{
+		OpportunityID: opportunityID,
+		NoteID:        noteID,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *DeleteNoteRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/notes/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.NoteID))
+}
+
+func (r *DeleteNoteRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+func (r *DeleteNoteRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+// Response for deleting a note.
+type DeleteNoteResponse struct {
+	BaseResponse
+}
+
+// Retrieve a single note
+//
+// This method returns the full note record for a single note on an opportunity.
+func (c *Client) GetNote(ctx context.Context, req *GetNoteRequest) (*GetNoteResponse, error) {
+	var respJSON getNoteResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var note model.Note
+	err := respJSON.Note.ToModel(&note)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetNoteResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Note:         &note,
+	}
+
+	return &resp, nil
+}
+
+// List all notes
+//
+// Lists all notes for an opportunity.
+func (c *Client) ListNotes(ctx context.Context, req *ListNotesRequest) (*ListNotesResponse, error) {
+	var respJSON listNotesResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	notes := make([]model.Note, len(respJSON.Notes))
+	for i := range respJSON.Notes {
+		err := respJSON.Notes[i].ToModel(&notes[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListNotesResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		Notes:            notes,
+	}
+
+	return &resp, nil
+}
+
+// Create a note
+//
+// Creates a note on an opportunity, optionally as a reply to an existing note thread.
+func (c *Client) CreateNote(ctx context.Context, req *CreateNoteRequest) (*CreateNoteResponse, error) {
+	if req.Value == "" {
+		return nil, errors.New("note value is required")
+	}
+
+	var respJSON createNoteResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var note model.Note
+	err := respJSON.Note.ToModel(&note)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := CreateNoteResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Note:         &note,
+	}
+
+	return &resp, nil
+}
+
+// Delete a note
+//
+// Deletes a note from an opportunity. Only notes created via the API can be deleted.
+func (c *Client) DeleteNote(ctx context.Context, req *DeleteNoteRequest) (*DeleteNoteResponse, error) {
+	var resp DeleteNoteResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,200 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotes(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{notePhoneScreen}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/notes"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": expandNote(notePhoneScreen, "user", "author")}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/notes/a1c8b7e2-9d3f-4e5a-8b6c-7d8e9f0a1b2c"),
+			testclient.ExpectQuery("expand", "user", "author"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Note was not found"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/notes/00000000-0000-0000-0000-000000000000"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// List notes
+	listResp, err := c.ListNotes(ctx, NewListNotesRequest("250d8f03-738a-4bba-a671-8a3d73477145"))
+
+	if ta.NoError(err) && ta.Len(listResp.Notes, 1) {
+		note := listResp.Notes[0]
+		ta.Equal("a1c8b7e2-9d3f-4e5a-8b6c-7d8e9f0a1b2c", note.ID)
+		ta.Equal("Great phone screen.", note.Text)
+		if ta.Len(note.Fields, 1) {
+			ta.Equal("note", note.Fields[0].Type)
+			ta.Equal("Great phone screen.", note.Fields[0].Value)
+		}
+		ta.True(note.Secret)
+		if ta.NotNil(note.Score) {
+			ta.Equal(3, *note.Score)
+		}
+		ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", note.UserID)
+		ta.Nil(note.User)
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", note.AuthorID)
+		ta.Nil(note.Author)
+		ta.Nil(note.DeletedAt)
+	}
+
+	// Get a note with the user and author expanded
+	getReq := NewGetNoteRequest("250d8f03-738a-4bba-a671-8a3d73477145", "a1c8b7e2-9d3f-4e5a-8b6c-7d8e9f0a1b2c")
+	getReq.Expand = []string{"user", "author"}
+	getResp, err := c.GetNote(ctx, getReq)
+
+	if ta.NoError(err) && ta.NotNil(getResp.Note) {
+		if ta.NotNil(getResp.Note.User) {
+			ta.Equal("Chandler Bing", getResp.Note.User.Name)
+		}
+		if ta.NotNil(getResp.Note.Author) {
+			ta.Equal("Rachel Green", getResp.Note.Author.Name)
+		}
+	}
+
+	// Get a note that does not exist
+	getResp, err = c.GetNote(ctx, NewGetNoteRequest("250d8f03-738a-4bba-a671-8a3d73477145", "00000000-0000-0000-0000-000000000000"))
+
+	if ta.Error(err) {
+		ta.Nil(getResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+}
+
+func TestCreateDeleteNote(t *testing.T) {
+	ta := assert.New(t)
+
+	const notesPath = "/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/notes"
+	const performAsID = "df0adaa6-172c-4cd6-8520-49b203660fe1"
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": notePhoneScreen}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath(notesPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectBody(`{"value":"Great phone screen.","secret":true,"score":3,"notifyFollowers":true}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": notePhoneScreen}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath(notesPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectQuery("note_id", "a1c8b7e2-9d3f-4e5a-8b6c-7d8e9f0a1b2c"),
+			testclient.ExpectBody(`{"value":"Agreed <3"}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath(notesPath+"/a1c8b7e2-9d3f-4e5a-8b6c-7d8e9f0a1b2c"),
+			testclient.ExpectQuery("perform_as", performAsID),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Create a note
+	score := 3
+	createReq := NewCreateNoteRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "Great phone screen.")
+	createReq.Secret = true
+	createReq.Score = &score
+	createReq.NotifyFollowers = true
+	createResp, err := c.CreateNote(ctx, createReq)
+
+	if ta.NoError(err) && ta.NotNil(createResp.Note) {
+		ta.Equal("a1c8b7e2-9d3f-4e5a-8b6c-7d8e9f0a1b2c", createResp.Note.ID)
+	}
+
+	// Reply to a note thread
+	replyReq := NewCreateNoteRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "Agreed <3")
+	replyReq.ReplyToNoteID = "a1c8b7e2-9d3f-4e5a-8b6c-7d8e9f0a1b2c"
+	_, err = c.CreateNote(ctx, replyReq)
+	ta.NoError(err)
+
+	// Notes without a value are rejected before anything is sent
+	_, err = c.CreateNote(ctx, NewCreateNoteRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", ""))
+	ta.Error(err)
+
+	// Delete a note
+	deleteReq := NewDeleteNoteRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "a1c8b7e2-9d3f-4e5a-8b6c-7d8e9f0a1b2c")
+	_, err = c.DeleteNote(ctx, deleteReq)
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+// expandNote expands the specified fields in the note data.
+func expandNote(orig map[string]any, fields ...string) map[string]any {
+	expanded := make(map[string]any)
+	for k, v := range orig {
+		expanded[k] = v
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "user":
+			expanded["user"] = expandUser(expanded["user"].(string))
+
+		case "author":
+			expanded["author"] = expandUser(expanded["author"].(string))
+		}
+	}
+
+	return expanded
+}
+
+var notePhoneScreen = map[string]any{
+	"id":   "a1c8b7e2-9d3f-4e5a-8b6c-7d8e9f0a1b2c",
+	"text": "Great phone screen.",
+	"fields": []map[string]any{
+		{
+			"type":  "note",
+			"text":  "note",
+			"value": "Great phone screen.",
+		},
+	},
+	"secret":      true,
+	"score":       3,
+	"user":        "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"author":      "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+	"createdAt":   1423187881576,
+	"completedAt": 1423187881576,
+	"deletedAt":   nil,
+}
@@ -0,0 +1,140 @@
+package lever
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Offer document statuses for downloads.
+const (
+	// The document that was sent to the candidate.
+	OfferDocumentSent = "sent"
+
+	// The document signed by the candidate.
+	OfferDocumentSigned = "signed"
+)
+
+// Lever offers client interface
+type OffersClientInterface interface {
+	ClientInterface
+
+	// List all offers
+	//
+	// Lists all offers for an opportunity.
+	ListOffers(ctx context.Context, req *ListOffersRequest) (*ListOffersResponse, error)
+
+	// Download an offer file.
+	//
+	// Downloads the sent or signed offer document if it exists.
+	//
+	// The caller is responsible for closing the response body after consuming it.
+	DownloadOffer(ctx context.Context, req *DownloadOfferRequest) (*http.Response, error)
+}
+
+// Parameters for listing offers.
+type ListOffersRequest struct {
+	BaseListRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+}
+
+// Create a new ListOffersRequest with the required fields.
+func NewListOffersRequest(opportunityID string) *ListOffersRequest {
+	return &ListOffersRequest{
+		OpportunityID: opportunityID,
+	}
+}
+
+func (r *ListOffersRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/offers", url.PathEscape(r.OpportunityID))
+}
+
+// Response for listing offers; returned to client users.
+type ListOffersResponse struct {
+	BaseListResponse
+
+	// The offer records.
+	Offers []model.Offer `json:"data"`
+}
+
+// JSON response type for listing offers, with some field types dynamically determined.
+type listOffersResponseJSON struct {
+	BaseListResponse
+
+	// The offer records.
+	Offers []multimodel.Offer `json:"data"`
+}
+
+// Parameters for downloading an offer file.
+type DownloadOfferRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The offer id. This is required.
+	OfferID string
+
+	// Which document to download: OfferDocumentSent or OfferDocumentSigned. If not specified,
+	// Lever returns the signed document if there is one, or the sent document otherwise.
+	Status string
+}
+
+// Create a new DownloadOfferRequest with the required fields.
+func NewDownloadOfferRequest(opportunityID, offerID string) *DownloadOfferRequest {
+	return &DownloadOfferRequest{
+		OpportunityID: opportunityID,
+		OfferID:       offerID,
+	}
+}
+
+func (r *DownloadOfferRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/offers/%s/download", url.PathEscape(r.OpportunityID), url.PathEscape(r.OfferID))
+}
+
+func (r *DownloadOfferRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.Status != "" {
+		query.Add(paramStatus, r.Status)
+	}
+}
+
+// List all offers
+//
+// Lists all offers for an opportunity.
+func (c *Client) ListOffers(ctx context.Context, req *ListOffersRequest) (*ListOffersResponse, error) {
+	var respJSON listOffersResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	offers := make([]model.Offer, len(respJSON.Offers))
+	for i := range respJSON.Offers {
+		err := respJSON.Offers[i].ToModel(&offers[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListOffersResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		Offers:           offers,
+	}
+
+	return &resp, nil
+}
+
+// Download an offer file.
+//
+// Downloads the sent or signed offer document if it exists.
+func (c *Client) DownloadOffer(ctx context.Context, req *DownloadOfferRequest) (*http.Response, error) {
+	return c.download(ctx, req)
+}
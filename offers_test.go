@@ -0,0 +1,257 @@
+package lever
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffers(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{offerSigned}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/offers"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": []map[string]any{expandOffer(offerSigned, "creator")}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/offers"),
+			testclient.ExpectQuery("expand", "creator"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List offers
+	listReq := NewListOffersRequest("250d8f03-738a-4bba-a671-8a3d73477145")
+	listResp, err := c.ListOffers(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.Offers, 1) {
+		offer := listResp.Offers[0]
+		ta.Equal("e3f7c1a9-6b2d-4c8e-9f0a-1b2c3d4e5f60", offer.ID)
+		ta.Equal(model.OfferStatusSigned, offer.Status)
+		ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", offer.CreatorID)
+		ta.Nil(offer.Creator)
+		if ta.NotNil(offer.ApprovedAt) {
+			ta.Equal(int64(1423587600000), *offer.ApprovedAt)
+		}
+		if field := offer.Field(model.OfferFieldSalaryAmount); ta.NotNil(field) {
+			ta.Equal(float64(120000), field.Value)
+		}
+		if field := offer.Field("signing_bonus"); ta.NotNil(field) {
+			ta.Equal("Signing bonus", field.Text)
+			bonus, ok := field.NumberValue()
+			ta.True(ok)
+			ta.Equal(float64(5000), bonus)
+		}
+		salary, ok := offer.SalaryAmount()
+		ta.True(ok)
+		ta.Equal(float64(120000), salary)
+		currency, ok := offer.SalaryCurrency()
+		ta.True(ok)
+		ta.Equal("USD", currency)
+		jobTitle, ok := offer.JobTitle()
+		ta.True(ok)
+		ta.Equal("Customer Success Manager", jobTitle)
+		startDate, ok := offer.StartDate()
+		ta.True(ok)
+		ta.Equal(time.UnixMilli(1425168000000), startDate)
+		_, ok = offer.SalaryInterval()
+		ta.False(ok)
+		ta.Nil(offer.Field("equity"))
+		if ta.NotNil(offer.SignedDocument) {
+			ta.Equal("Offer Letter - Signed.pdf", offer.SignedDocument.FileName)
+		}
+	}
+
+	// List offers with the creator expanded
+	listReq.Expand = []string{"creator"}
+	listResp, err = c.ListOffers(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.Offers, 1) {
+		if ta.NotNil(listResp.Offers[0].Creator) {
+			ta.Equal("Chandler Bing", listResp.Offers[0].Creator.Name)
+		}
+	}
+}
+
+func TestOfferFieldValues(t *testing.T) {
+	ta := assert.New(t)
+
+	// Values as decoded from JSON
+	text := model.OfferField{Identifier: model.OfferFieldJobTitle, Value: "Customer Success Manager"}
+	number := model.OfferField{Identifier: model.OfferFieldSalaryAmount, Value: float64(120000)}
+	numericText := model.OfferField{Identifier: "signing_bonus", Value: "5000.50"}
+	date := model.OfferField{Identifier: model.OfferFieldAnticipatedStartDate, Value: float64(1425168000000)}
+	empty := model.OfferField{Identifier: "notes"}
+
+	value, ok := text.StringValue()
+	ta.True(ok)
+	ta.Equal("Customer Success Manager", value)
+	_, ok = text.NumberValue()
+	ta.False(ok)
+	_, ok = text.DateValue()
+	ta.False(ok)
+
+	amount, ok := number.NumberValue()
+	ta.True(ok)
+	ta.Equal(float64(120000), amount)
+	_, ok = number.StringValue()
+	ta.False(ok)
+
+	amount, ok = numericText.NumberValue()
+	ta.True(ok)
+	ta.Equal(5000.5, amount)
+
+	startDate, ok := date.DateValue()
+	ta.True(ok)
+	ta.Equal(time.Date(2015, time.March, 1, 0, 0, 0, 0, time.UTC), startDate.UTC())
+
+	_, ok = empty.StringValue()
+	ta.False(ok)
+	_, ok = empty.NumberValue()
+	ta.False(ok)
+	_, ok = empty.DateValue()
+	ta.False(ok)
+
+	// Missing fields
+	var offer model.Offer
+	_, ok = offer.SalaryAmount()
+	ta.False(ok)
+	_, ok = offer.StartDate()
+	ta.False(ok)
+	_, ok = offer.Field("signing_bonus").NumberValue()
+	ta.False(ok)
+}
+
+func TestDownloadOffer(t *testing.T) {
+	ta := assert.New(t)
+
+	const offerPath = "/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/offers/e3f7c1a9-6b2d-4c8e-9f0a-1b2c3d4e5f60/download"
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			"%PDF-1.4 signed",
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(offerPath),
+			testclient.ExpectQuery("status", "signed"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Offer has no sent document"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(offerPath),
+			testclient.ExpectQuery("status", "sent"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusBadGateway,
+			"<html>Bad Gateway</html>",
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(offerPath),
+			testclient.ExpectNoQuery(),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// Download the signed document
+	req := NewDownloadOfferRequest("250d8f03-738a-4bba-a671-8a3d73477145", "e3f7c1a9-6b2d-4c8e-9f0a-1b2c3d4e5f60")
+	req.Status = OfferDocumentSigned
+	resp, err := c.DownloadOffer(ctx, req)
+
+	if ta.NoError(err) {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		ta.NoError(err)
+		ta.Equal("%PDF-1.4 signed", string(body))
+	}
+
+	// Download a sent document that does not exist
+	req.Status = OfferDocumentSent
+	resp, err = c.DownloadOffer(ctx, req)
+
+	if ta.Error(err) {
+		ta.Nil(resp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+
+	// Error responses that are not JSON still produce a LeverError
+	req.Status = ""
+	resp, err = c.DownloadOffer(ctx, req)
+
+	if ta.Error(err) {
+		ta.Nil(resp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("502 Bad Gateway", leverError.Code)
+			ta.Equal(http.StatusBadGateway, leverError.HTTPResponse.StatusCode)
+		}
+	}
+}
+
+// expandOffer expands the specified fields in the offer data.
+func expandOffer(orig map[string]any, fields ...string) map[string]any {
+	expanded := make(map[string]any)
+	for k, v := range orig {
+		expanded[k] = v
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "creator":
+			expanded["creator"] = expandUser(expanded["creator"].(string))
+		}
+	}
+
+	return expanded
+}
+
+var offerSigned = map[string]any{
+	"id":         "e3f7c1a9-6b2d-4c8e-9f0a-1b2c3d4e5f60",
+	"createdAt":  1423187881576,
+	"status":     "signed",
+	"creator":    "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"approvedAt": 1423587600000,
+	"sentAt":     1423597600000,
+	"fields": []map[string]any{
+		{"text": "Job title", "identifier": "job_title", "value": "Customer Success Manager"},
+		{"text": "Salary", "identifier": "salary_amount", "value": 120000},
+		{"text": "Currency", "identifier": "salary_currency", "value": "USD"},
+		{"text": "Anticipated start date", "identifier": "anticipated_start_date", "value": 1425168000000},
+		{"text": "Signing bonus", "identifier": "signing_bonus", "value": 5000},
+	},
+	"sentDocument": map[string]any{
+		"fileName":    "Offer Letter.pdf",
+		"uploadedAt":  1423597600000,
+		"downloadUrl": "https://api.lever.co/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/offers/e3f7c1a9-6b2d-4c8e-9f0a-1b2c3d4e5f60/download?status=sent",
+	},
+	"signedDocument": map[string]any{
+		"fileName":    "Offer Letter - Signed.pdf",
+		"uploadedAt":  1423697600000,
+		"downloadUrl": "https://api.lever.co/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/offers/e3f7c1a9-6b2d-4c8e-9f0a-1b2c3d4e5f60/download?status=signed",
+	},
+}
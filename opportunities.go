@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime"
@@ -486,12 +487,21 @@ type CreateOpportunityRequest struct {
 	// creator will always be added as a follower.
 	FollowerIDs []string
 
-	// Resume file for this Opportunity.
+	// Resume file for this Opportunity. This cannot be combined with ResumeUpload.
 	ResumeFile *model.Reader
 
+	// A previously uploaded resume file for this Opportunity (see [Client.UploadFile]). This is
+	// sent by reference instead of resending the file contents. This cannot be combined with
+	// ResumeFile.
+	ResumeUpload *model.Upload
+
 	// File(s) relating to this Opportunity.
 	Files []model.Reader
 
+	// Previously uploaded file(s) relating to this Opportunity (see [Client.UploadFile]). These are
+	// sent by reference after any files in Files.
+	FileUploads []model.Upload
+
 	// Posting ID for this opportunity.
 	PostingID string
 
@@ -551,12 +561,13 @@ func (r *CreateOpportunityRequest) AddAPIQueryParams(query *url.Values) {
 }
 
 func (r *CreateOpportunityRequest) GetBody() (io.Reader, error) {
-	reader, pipeWriter := io.Pipe()
-	writer := multipart.NewWriter(pipeWriter)
-	r.contentType = writer.FormDataContentType()
+	if r.ResumeFile != nil && r.ResumeUpload != nil {
+		return nil, errors.New("only one of ResumeFile and ResumeUpload may be specified")
+	}
 
-	go r.writeBody(writer)
-	return reader, nil
+	body, contentType := newMultipartBody(r.writeBody)
+	r.contentType = contentType
+	return body, nil
 }
 
 func (r *CreateOpportunityRequest) GetContentType() string {
@@ -564,9 +575,7 @@ func (r *CreateOpportunityRequest) GetContentType() string {
 }
 
 // writeBody writes the body of the request to the provided writer.
-func (r *CreateOpportunityRequest) writeBody(w *multipart.Writer) {
-	defer w.Close()
-
+func (r *CreateOpportunityRequest) writeBody(w *multipart.Writer) error {
 	if r.Name != "" {
 		w.WriteField("name", r.Name)
 	}
@@ -644,15 +653,25 @@ func (r *CreateOpportunityRequest) writeBody(w *multipart.Writer) {
 
 	if r.ResumeFile != nil {
 		if err := writeMultipartFile(w, "resume", r.ResumeFile); err != nil {
-			return
+			return err
 		}
 	}
 
+	if r.ResumeUpload != nil {
+		w.WriteField("resume", r.ResumeUpload.URI)
+	}
+
 	for i, file := range r.Files {
 		if err := writeMultipartFile(w, fmt.Sprintf("files[%d]", i), &file); err != nil {
-			return
+			return err
 		}
 	}
+
+	for i, upload := range r.FileUploads {
+		w.WriteField(fmt.Sprintf("files[%d]", len(r.Files)+i), upload.URI)
+	}
+
+	return nil
 }
 
 // Write a file to the request body.
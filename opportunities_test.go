@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
 	"github.com/corbaltcode/lever-data-api-go/model"
@@ -201,6 +204,110 @@ func TestOpportunities(t *testing.T) {
 	}
 }
 
+func TestOpportunityExpandApplications(t *testing.T) {
+	ta := assert.New(t)
+
+	expanded := expandCandidate(shaneSmith, "applications", "contact")
+	expanded["applications"] = expandApplications([]string{"cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"}, "posting", "postingOwner", "postingHiringManager")
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": expandCandidate(shaneSmith)}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{expandCandidate(shaneSmith, "applications", "contact")}}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities"),
+			testclient.ExpectQuery("expand", "applications"),
+			testclient.ExpectQuery("expand", "contact"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": expanded}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145"),
+			testclient.ExpectQuery("expand", "applications"),
+			testclient.ExpectQuery("expand", "contact"),
+			testclient.ExpectQuery("expand", "posting"),
+			testclient.ExpectQuery("expand", "postingOwner"),
+			testclient.ExpectQuery("expand", "postingHiringManager"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Without expansion, only IDs are returned
+	getReq := NewGetOpportunityRequest("250d8f03-738a-4bba-a671-8a3d73477145")
+	getResp, err := c.GetOpportunity(ctx, getReq)
+	if ta.NoError(err) && ta.NotNil(getResp.Opportunity) {
+		opportunity := getResp.Opportunity
+		ta.Equal([]string{"cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"}, opportunity.ApplicationIDs)
+		ta.Nil(opportunity.Applications)
+		ta.Equal("7f23e772-f2cb-4ebb-b33f-54b872999992", opportunity.ContactID)
+		ta.Nil(opportunity.Contact)
+	}
+
+	// Expand applications and contact
+	listReq := NewListOpportunitiesRequest()
+	listReq.Expand = []string{"applications", "contact"}
+	listResp, err := c.ListOpportunities(ctx, listReq)
+	if ta.NoError(err) && ta.Len(listResp.Opportunities, 1) {
+		opportunity := listResp.Opportunities[0]
+		ta.Equal([]string{"cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"}, opportunity.ApplicationIDs)
+		if ta.Len(opportunity.Applications, 1) {
+			application := opportunity.Applications[0]
+			ta.Equal("cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa", application.ID)
+			ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", application.PostingID)
+			ta.Nil(application.Posting)
+			ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", application.PostingOwnerID)
+			ta.Nil(application.PostingOwner)
+		}
+
+		ta.Equal("7f23e772-f2cb-4ebb-b33f-54b872999992", opportunity.ContactID)
+		if ta.NotNil(opportunity.Contact) {
+			ta.Equal("Shane Smith", opportunity.Contact.Name)
+			ta.Equal([]string{"shane@exampleq3.com"}, opportunity.Contact.Emails)
+		}
+	}
+
+	// Expand the application's posting, posting owner and posting hiring manager as well
+	getReq.Expand = []string{"applications", "contact", "posting", "postingOwner", "postingHiringManager"}
+	getResp, err = c.GetOpportunity(ctx, getReq)
+	if ta.NoError(err) && ta.NotNil(getResp.Opportunity) && ta.Len(getResp.Opportunity.Applications, 1) {
+		application := getResp.Opportunity.Applications[0]
+		ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", application.PostingID)
+		if ta.NotNil(application.Posting) {
+			ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", application.Posting.ID)
+			ta.Equal("Customer Success Manager", application.Posting.Text)
+		}
+
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", application.PostingOwnerID)
+		if ta.NotNil(application.PostingOwner) {
+			ta.Equal("Rachel Green", application.PostingOwner.Name)
+		}
+
+		ta.Equal("022d6639-1333-419b-9635-31f93015335f", application.PostingHiringManagerID)
+		if ta.NotNil(application.PostingHiringManager) {
+			ta.Equal("Monica Geller", application.PostingHiringManager.Name)
+		}
+
+		// Not expanded
+		ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", application.UserID)
+		ta.Nil(application.User)
+	}
+
+	ta.Empty(s.Expected)
+}
+
 // TestCreateCandidate tests creating a candidate.
 func TestCreateCandidate(t *testing.T) {
 	ta := assert.New(t)
@@ -318,6 +425,49 @@ func TestCreateCandidate(t *testing.T) {
 
 }
 
+// TestCreateOpportunityServer sends the multipart body over a real HTTP connection, which only
+// completes if the body is terminated with EOF.
+func TestCreateOpportunityServer(t *testing.T) {
+	ta := assert.New(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"id":"cb45668d-38b6-43dd-9ba5-bd325d50dbfc","name":"Dave Test Candidate DONOTUSE"}}`))
+	}))
+
+	// Fail instead of hanging if the body is never terminated.
+	server.Config.ReadTimeout = 5 * time.Second
+	server.Start()
+	defer server.Close()
+	defer server.CloseClientConnections()
+
+	c := NewClient(WithBaseURL(server.URL))
+
+	createReq := NewCreateOpportunityRequest("68d28c32-e972-4f0f-81c7-83f2e9430293")
+	createReq.Name = "Dave Test Candidate DONOTUSE"
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		createResp, err := c.CreateOpportunity(context.Background(), createReq)
+		if ta.NoError(err) && ta.NotNil(createResp.Opportunity) {
+			ta.Equal("cb45668d-38b6-43dd-9ba5-bd325d50dbfc", createResp.Opportunity.ID)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("CreateOpportunity did not complete")
+	}
+}
+
 // expandCandidates expands the specified fields in the array of candidate data.
 func expandCandidates(orig []map[string]any, fields ...string) []map[string]any {
 	expanded := make([]map[string]any, 0, len(orig))
@@ -351,6 +501,17 @@ func expandCandidate(orig map[string]any, fields ...string) map[string]any {
 
 		case "stage":
 			expanded["stage"] = expandStage(expanded["stage"].(string))
+
+		case "applications":
+			expanded["applications"] = expandApplications(expanded["applications"].([]string))
+
+		case "contact":
+			contact, ok := contactIDToContact[expanded["contact"].(string)]
+			if !ok {
+				panic(fmt.Sprintf("No contact found for ID %s", expanded["contact"]))
+			}
+
+			expanded["contact"] = contact
 		}
 	}
 
@@ -408,6 +569,18 @@ func expandUser(userID string) map[string]any {
 	panic(fmt.Sprintf("No user found for ID %s", userID))
 }
 
+var contactIDToContact = map[string]map[string]any{
+	"7f23e772-f2cb-4ebb-b33f-54b872999992": {
+		"id":           "7f23e772-f2cb-4ebb-b33f-54b872999992",
+		"name":         "Shane Smith",
+		"headline":     "Brickly LLC, Vandelay Industries, Inc, Central Perk",
+		"isAnonymized": false,
+		"location":     map[string]any{"name": "Oakland"},
+		"emails":       []string{"shane@exampleq3.com"},
+		"phones":       []map[string]any{{"type": "mobile", "value": "(123) 456-7891"}},
+	},
+}
+
 var shaneSmith = map[string]any{
 	"id":       "250d8f03-738a-4bba-a671-8a3d73477145",
 	"name":     "Shane Smith",
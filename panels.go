@@ -0,0 +1,531 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever panels client interface
+type PanelsClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single panel
+	//
+	// This method returns the full panel record, including its interviews, for a single panel on
+	// an opportunity.
+	GetPanel(ctx context.Context, req *GetPanelRequest) (*GetPanelResponse, error)
+
+	// List all panels
+	//
+	// Lists all interview panels for an opportunity.
+	ListPanels(ctx context.Context, req *ListPanelsRequest) (*ListPanelsResponse, error)
+
+	// Create a panel
+	//
+	// Creates an externally managed interview panel, along with its interviews, on an
+	// opportunity.
+	CreatePanel(ctx context.Context, req *CreatePanelRequest) (*CreatePanelResponse, error)
+
+	// Update a panel
+	//
+	// Updates an externally managed interview panel. This replaces the panel record, including
+	// its interviews; all fields must be specified.
+	UpdatePanel(ctx context.Context, req *UpdatePanelRequest) (*UpdatePanelResponse, error)
+
+	// Delete a panel
+	//
+	// Deletes an externally managed interview panel and its interviews.
+	DeletePanel(ctx context.Context, req *DeletePanelRequest) (*DeletePanelResponse, error)
+}
+
+// Parameters for retrieving a single panel.
+type GetPanelRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The panel id. This is required.
+	PanelID string
+}
+
+// Create a new GetPanelRequest with the required fields.
+func NewGetPanelRequest(opportunityID, panelID string) *GetPanelRequest {
+	return &GetPanelRequest{
+		OpportunityID: opportunityID,
+		PanelID:       panelID,
+	}
+}
+
+func (r *GetPanelRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/panels/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.PanelID))
+}
+
+// Response for retrieving a single panel; returned to client users.
+type GetPanelResponse struct {
+	BaseResponse
+
+	// The panel record.
+	Panel *model.Panel `json:"data"`
+}
+
+// JSON response type for retrieving a single panel, with some field types dynamically determined.
+type getPanelResponseJSON struct {
+	BaseResponse
+
+	// The panel record.
+	Panel *multimodel.Panel `json:"data"`
+}
+
+// Parameters for listing panels.
+type ListPanelsRequest struct {
+	BaseListRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+}
+
+// Create a new ListPanelsRequest with the required fields.
+func NewListPanelsRequest(opportunityID string) *ListPanelsRequest {
+	return &ListPanelsRequest{
+		OpportunityID: opportunityID,
+	}
+}
+
+func (r *ListPanelsRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/panels", url.PathEscape(r.OpportunityID))
+}
+
+// Response for listing panels; returned to client users.
+type ListPanelsResponse struct {
+	BaseListResponse
+
+	// The panel records.
+	Panels []model.Panel `json:"data"`
+}
+
+// JSON response type for listing panels, with some field types dynamically determined.
+type listPanelsResponseJSON struct {
+	BaseListResponse
+
+	// The panel records.
+	Panels []multimodel.Panel `json:"data"`
+}
+
+// Fields common to panel create and update requests.
+type PanelFields struct {
+	// The application ids the panel is associated with.
+	Applications []string
+
+	// Name of timezone in which the panel is scheduled to occur.
+	Timezone string
+
+	// Frequency of feedback reminders (i.e. once, daily, frequently, none).
+	FeedbackReminder string
+
+	// Panel note
+	Note string
+
+	// A URL linking to the panel in an external scheduling system.
+	ExternalURL string
+
+	// The interviews in the panel. At least one interview is required; each interview must have
+	// interviewers, a date and a duration. The interview ID, PanelID and read-only fields (e.g.
+	// CreatedAt, FeedbackForms) are ignored.
+	Interviews []model.Interview
+}
+
+// Check that the required panel fields are present. All problems found are returned, joined with
+// [errors.Join].
+func (f *PanelFields) Validate() error {
+	var errs []error
+
+	if len(f.Interviews) == 0 {
+		errs = append(errs, errors.New("at least one interview is required"))
+	}
+
+	for i := range f.Interviews {
+		interview := &f.Interviews[i]
+		for _, err := range validateInterviewSchedule(interview.Interviewers, interview.Date, interview.Duration) {
+			errs = append(errs, fmt.Errorf("interview %d: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// JSON body for the panel create and update requests.
+type panelRequestBody struct {
+	Applications     []string               `json:"applications,omitempty"`
+	Timezone         string                 `json:"timezone,omitempty"`
+	FeedbackReminder string                 `json:"feedbackReminder,omitempty"`
+	Note             string                 `json:"note,omitempty"`
+	ExternalURL      string                 `json:"externalUrl,omitempty"`
+	Interviews       []interviewRequestBody `json:"interviews"`
+}
+
+// Encode the panel fields as a JSON request body.
+func (f *PanelFields) encode() (io.Reader, error) {
+	body := panelRequestBody{
+		Applications:     f.Applications,
+		Timezone:         f.Timezone,
+		FeedbackReminder: f.FeedbackReminder,
+		Note:             f.Note,
+		ExternalURL:      f.ExternalURL,
+		Interviews:       make([]interviewRequestBody, len(f.Interviews)),
+	}
+
+	for i, interview := range f.Interviews {
+		body.Interviews[i] = interviewRequestBody{
+			Subject:            interview.Subject,
+			Note:               interview.Note,
+			Interviewers:       newInterviewerRequestBodies(interview.Interviewers),
+			Date:               interview.Date,
+			Duration:           interview.Duration,
+			Location:           interview.Location,
+			FeedbackTemplateID: interview.FeedbackTemplateID,
+			FeedbackReminder:   interview.FeedbackReminder,
+			Timezone:           interview.Timezone,
+		}
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Parameters for creating a panel.
+type CreatePanelRequest struct {
+	BaseRequest
+	PanelFields
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// Perform this create on behalf of a specified user. This is required.
+	PerformAsID string
+}
+
+// Create a new CreatePanelRequest with the required fields.
+func NewCreatePanelRequest(performAsID, opportunityID string) *CreatePanelRequest {
+	return &CreatePanelRequest{
+		OpportunityID: opportunityID,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *CreatePanelRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/panels", url.PathEscape(r.OpportunityID))
+}
+
+func (r *CreatePanelRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreatePanelRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *CreatePanelRequest) GetBody() (io.Reader, error) {
+	return r.PanelFields.encode()
+}
+
+// Response for creating a panel; returned to client users.
+type CreatePanelResponse struct {
+	BaseResponse
+
+	// The panel record.
+	Panel *model.Panel `json:"data"`
+}
+
+// JSON response type for creating a panel, with some field types dynamically determined.
+type createPanelResponseJSON struct {
+	BaseResponse
+
+	// The panel record.
+	Panel *multimodel.Panel `json:"data"`
+}
+
+// Parameters for updating a panel.
+type UpdatePanelRequest struct {
+	BaseRequest
+	PanelFields
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The panel id. This is required.
+	PanelID string
+
+	// Perform this update on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// Skip checking that the panel is externally managed before updating it. By default, the
+	// panel is retrieved first so that [ErrPanelNotExternallyManaged] can be returned instead of a
+	// less descriptive API error.
+	SkipPanelCheck bool
+}
+
+// Create a new UpdatePanelRequest with the required fields.
+func NewUpdatePanelRequest(performAsID, opportunityID, panelID string) *UpdatePanelRequest {
+	return &UpdatePanelRequest{
+		OpportunityID: opportunityID,
+		PanelID:       panelID,
+		PerformAsID:   performAsID,
+	}
+}
+
+// Create a new UpdatePanelRequest based on an existing Panel struct.
+func NewUpdatePanelRequestFromPanel(performAsID, opportunityID string, panel *model.Panel) *UpdatePanelRequest {
+	return &UpdatePanelRequest{
+		PanelFields: PanelFields{
+			Applications:     panel.Applications,
+			Timezone:         panel.Timezone,
+			FeedbackReminder: panel.FeedbackReminder,
+			Note:             panel.Note,
+			ExternalURL:      panel.ExternalURL,
+			Interviews:       panel.Interviews,
+		},
+		OpportunityID: opportunityID,
+		PanelID:       panel.ID,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *UpdatePanelRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/panels/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.PanelID))
+}
+
+func (r *UpdatePanelRequest) GetHTTPMethod() string {
+	return http.MethodPut
+}
+
+func (r *UpdatePanelRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *UpdatePanelRequest) GetBody() (io.Reader, error) {
+	return r.PanelFields.encode()
+}
+
+// Response for updating a panel; returned to client users.
+type UpdatePanelResponse struct {
+	BaseResponse
+
+	// The panel record.
+	Panel *model.Panel `json:"data"`
+}
+
+// JSON response type for updating a panel, with some field types dynamically determined.
+type updatePanelResponseJSON struct {
+	BaseResponse
+
+	// The panel record.
+	Panel *multimodel.Panel `json:"data"`
+}
+
+// Parameters for deleting a panel.
+type DeletePanelRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The panel id. This is required.
+	PanelID string
+
+	// Perform this delete on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// Skip checking that the panel is externally managed before deleting it.
+	SkipPanelCheck bool
+}
+
+// Create a new DeletePanelRequest with the required fields.
+func NewDeletePanelRequest(performAsID, opportunityID, panelID string) *DeletePanelRequest {
+	return &DeletePanelRequest{
+		OpportunityID: opportunityID,
+		PanelID:       panelID,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *DeletePanelRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/panels/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.PanelID))
+}
+
+func (r *DeletePanelRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+func (r *DeletePanelRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+// Response for deleting a panel.
+type DeletePanelResponse struct {
+	BaseResponse
+}
+
+// Retrieve a single panel
+//
+// This method returns the full panel record, including its interviews, for a single panel on an
+// opportunity.
+func (c *Client) GetPanel(ctx context.Context, req *GetPanelRequest) (*GetPanelResponse, error) {
+	var respJSON getPanelResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var panel model.Panel
+	err := respJSON.Panel.ToModel(&panel)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetPanelResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Panel:        &panel,
+	}
+
+	return &resp, nil
+}
+
+// List all panels
+//
+// Lists all interview panels for an opportunity.
+func (c *Client) ListPanels(ctx context.Context, req *ListPanelsRequest) (*ListPanelsResponse, error) {
+	var respJSON listPanelsResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	panels := make([]model.Panel, len(respJSON.Panels))
+	for i := range respJSON.Panels {
+		err := respJSON.Panels[i].ToModel(&panels[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListPanelsResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		Panels:           panels,
+	}
+
+	return &resp, nil
+}
+
+// Create a panel
+//
+// Creates an externally managed interview panel, along with its interviews, on an opportunity.
+func (c *Client) CreatePanel(ctx context.Context, req *CreatePanelRequest) (*CreatePanelResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var respJSON createPanelResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var panel model.Panel
+	err := respJSON.Panel.ToModel(&panel)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := CreatePanelResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Panel:        &panel,
+	}
+
+	return &resp, nil
+}
+
+// Update a panel
+//
+// Updates an externally managed interview panel. This replaces the panel record, including its
+// interviews; all fields must be specified.
+func (c *Client) UpdatePanel(ctx context.Context, req *UpdatePanelRequest) (*UpdatePanelResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !req.SkipPanelCheck {
+		if err := c.checkPanelExternallyManaged(ctx, req.OpportunityID, req.PanelID); err != nil {
+			return nil, err
+		}
+	}
+
+	var respJSON updatePanelResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var panel model.Panel
+	err := respJSON.Panel.ToModel(&panel)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := UpdatePanelResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Panel:        &panel,
+	}
+
+	return &resp, nil
+}
+
+// Delete a panel
+//
+// Deletes an externally managed interview panel and its interviews.
+func (c *Client) DeletePanel(ctx context.Context, req *DeletePanelRequest) (*DeletePanelResponse, error) {
+	if !req.SkipPanelCheck {
+		if err := c.checkPanelExternallyManaged(ctx, req.OpportunityID, req.PanelID); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp DeletePanelResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Return [ErrPanelNotExternallyManaged] if the given panel is managed by Lever.
+func (c *Client) checkPanelExternallyManaged(ctx context.Context, opportunityID, panelID string) error {
+	resp, err := c.GetPanel(ctx, NewGetPanelRequest(opportunityID, panelID))
+	if err != nil {
+		return err
+	}
+
+	if !resp.Panel.ExternallyManaged {
+		return fmt.Errorf("panel %s: %w", panelID, ErrPanelNotExternallyManaged)
+	}
+
+	return nil
+}
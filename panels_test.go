@@ -0,0 +1,260 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPanels(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{panelOnSite}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/panels"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": expandPanel(panelOnSite, "user", "stage")}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/panels/8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0"),
+			testclient.ExpectQuery("expand", "user", "stage"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Panel was not found"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/panels/00000000-0000-0000-0000-000000000000"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// List panels
+	listReq := NewListPanelsRequest("250d8f03-738a-4bba-a671-8a3d73477145")
+	listResp, err := c.ListPanels(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.Panels, 1) {
+		panel := listResp.Panels[0]
+		ta.Equal("8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0", panel.ID)
+		ta.Equal([]string{"f1d7b3b0-7e1f-4b6c-9d4a-2c3e5f6a7b8c"}, panel.Applications)
+		ta.Equal("America/Los_Angeles", panel.Timezone)
+		ta.Equal("https://scheduler.example.com/loops/1234", panel.ExternalURL)
+		ta.True(panel.ExternallyManaged)
+		ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", panel.UserID)
+		ta.Nil(panel.User)
+		if ta.Len(panel.Interviews, 1) {
+			ta.Equal("3d2a6a7d-48f6-4bbd-b6f6-53e6c1d1f2c6", panel.Interviews[0].ID)
+			ta.Equal(panel.ID, panel.Interviews[0].PanelID)
+			ta.Equal([]string{"f2f01e16-27f8-4711-a728-7d49499795a0"}, panel.Interviews[0].PostingIDs)
+		}
+	}
+
+	// Get a panel with the user and stage expanded
+	getReq := NewGetPanelRequest("250d8f03-738a-4bba-a671-8a3d73477145", "8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0")
+	getReq.Expand = []string{"user", "stage"}
+	getResp, err := c.GetPanel(ctx, getReq)
+
+	if ta.NoError(err) && ta.NotNil(getResp.Panel) {
+		if ta.NotNil(getResp.Panel.User) {
+			ta.Equal(getResp.Panel.UserID, getResp.Panel.User.ID)
+		}
+		if ta.NotNil(getResp.Panel.Stage) {
+			ta.Equal(getResp.Panel.StageID, getResp.Panel.Stage.ID)
+		}
+		ta.Len(getResp.Panel.Interviews, 1)
+	}
+
+	// Get a panel that does not exist
+	getReq = NewGetPanelRequest("250d8f03-738a-4bba-a671-8a3d73477145", "00000000-0000-0000-0000-000000000000")
+	getResp, err = c.GetPanel(ctx, getReq)
+
+	if ta.Error(err) {
+		ta.Nil(getResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+}
+
+func TestCreateUpdateDeletePanel(t *testing.T) {
+	ta := assert.New(t)
+
+	const panelsPath = "/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/panels"
+	const panelPath = panelsPath + "/8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0"
+	const performAsID = "df0adaa6-172c-4cd6-8520-49b203660fe1"
+
+	createBody := `{"applications":["f1d7b3b0-7e1f-4b6c-9d4a-2c3e5f6a7b8c"],"timezone":"America/Los_Angeles","externalUrl":"https://scheduler.example.com/loops/1234","interviews":[{"subject":"On-site interview","interviewers":[{"id":"ecdb6670-d9f3-4b87-8267-1cde26d1bc42","feedbackTemplate":"d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2"}],"date":1423587600000,"duration":60,"location":"Conference room A"},{"subject":"Lunch","interviewers":[{"id":"ecdb6670-d9f3-4b87-8267-1cde26d1bc42"}],"date":1423591200000,"duration":45}]}` + "\n"
+	updateBody := `{"applications":["f1d7b3b0-7e1f-4b6c-9d4a-2c3e5f6a7b8c"],"timezone":"America/Los_Angeles","feedbackReminder":"daily","note":"Onsite loop","externalUrl":"https://scheduler.example.com/loops/1234","interviews":[{"subject":"On-site interview","note":"Please bring a laptop.","interviewers":[{"id":"ecdb6670-d9f3-4b87-8267-1cde26d1bc42"}],"date":1423587600000,"duration":60,"location":"Conference room A","feedbackTemplate":"d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2","feedbackReminder":"frequently","timezone":"America/Los_Angeles"}]}` + "\n"
+
+	managed := `{"data":{"id":"8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0","externallyManaged":true}}`
+	updated := expandPanel(panelOnSite)
+	updated["feedbackReminder"] = "daily"
+
+	s := testclient.NewExpectManyHandler(
+		// Create a panel
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": panelOnSite}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath(panelsPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectBody(createBody),
+		),
+
+		// Update a panel
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			managed,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(panelPath),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": updated}),
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath(panelPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectBody(updateBody),
+		),
+
+		// Delete a panel managed by Lever
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			`{"data":{"id":"8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0","externallyManaged":false}}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(panelPath),
+		),
+
+		// Delete a panel
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			managed,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(panelPath),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath(panelPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Create a panel with two interviews
+	start := int64(1423587600000)
+	lunch := int64(1423591200000)
+	createReq := NewCreatePanelRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145")
+	createReq.Applications = []string{"f1d7b3b0-7e1f-4b6c-9d4a-2c3e5f6a7b8c"}
+	createReq.Timezone = "America/Los_Angeles"
+	createReq.ExternalURL = "https://scheduler.example.com/loops/1234"
+	createReq.Interviews = []model.Interview{
+		{
+			Subject:      "On-site interview",
+			Interviewers: []model.Interviewer{{ID: "ecdb6670-d9f3-4b87-8267-1cde26d1bc42", FeedbackTemplateID: "d9c4a9a3-3c7a-4b5d-8d6e-9fb0f8c0f1a2"}},
+			Date:         &start,
+			Duration:     60,
+			Location:     "Conference room A",
+		},
+		{
+			Subject:      "Lunch",
+			Interviewers: []model.Interviewer{{ID: "ecdb6670-d9f3-4b87-8267-1cde26d1bc42"}},
+			Date:         &lunch,
+			Duration:     45,
+		},
+	}
+	createResp, err := c.CreatePanel(ctx, createReq)
+
+	if ta.NoError(err) && ta.NotNil(createResp.Panel) {
+		ta.Equal("8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0", createResp.Panel.ID)
+		ta.True(createResp.Panel.ExternallyManaged)
+	}
+
+	// Update a panel
+	updateReq := NewUpdatePanelRequestFromPanel(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", createResp.Panel)
+	updateReq.FeedbackReminder = "daily"
+	updateResp, err := c.UpdatePanel(ctx, updateReq)
+
+	if ta.NoError(err) && ta.NotNil(updateResp.Panel) {
+		ta.Equal("daily", updateResp.Panel.FeedbackReminder)
+	}
+
+	// Invalid requests are rejected before anything is sent
+	invalidReq := NewCreatePanelRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145")
+	invalidReq.Interviews = []model.Interview{{Subject: "On-site interview"}}
+	createResp, err = c.CreatePanel(ctx, invalidReq)
+	if ta.Error(err) {
+		ta.Nil(createResp)
+		ta.ErrorContains(err, "interview 0: at least one interviewer is required")
+		ta.ErrorContains(err, "interview 0: date is required")
+	}
+
+	// Delete a panel managed by Lever
+	deleteReq := NewDeletePanelRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0")
+	_, err = c.DeletePanel(ctx, deleteReq)
+	ta.ErrorIs(err, ErrPanelNotExternallyManaged)
+
+	// Delete a panel
+	_, err = c.DeletePanel(ctx, deleteReq)
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+// expandPanel expands the specified fields in the panel data.
+func expandPanel(orig map[string]any, fields ...string) map[string]any {
+	expanded := make(map[string]any)
+	for k, v := range orig {
+		expanded[k] = v
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "user":
+			expanded["user"] = expandUser(expanded["user"].(string))
+
+		case "stage":
+			expanded["stage"] = expandStage(expanded["stage"].(string))
+		}
+	}
+
+	return expanded
+}
+
+var panelOnSite = map[string]any{
+	"id":                "8a2f9b45-8f6e-4f4a-a2c6-1c6f46a5a7f0",
+	"applications":      []string{"f1d7b3b0-7e1f-4b6c-9d4a-2c3e5f6a7b8c"},
+	"canceledAt":        nil,
+	"createdAt":         1423187881576,
+	"start":             1423587600000,
+	"end":               1423591200000,
+	"timezone":          "America/Los_Angeles",
+	"feedbackReminder":  "frequently",
+	"user":              "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"stage":             "00922a60-7c15-422b-b086-f62000824fd7",
+	"note":              "Onsite loop",
+	"externalUrl":       "https://scheduler.example.com/loops/1234",
+	"externallyManaged": true,
+	"interviews":        []map[string]any{interviewOnSite},
+}
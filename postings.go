@@ -0,0 +1,909 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever postings client interface
+type PostingsClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single posting
+	GetPosting(ctx context.Context, req *GetPostingRequest) (*GetPostingResponse, error)
+
+	// List all postings
+	//
+	// Lists all postings in your Lever account.
+	ListPostings(ctx context.Context, req *ListPostingsRequest) (*ListPostingsResponse, error)
+
+	// Create a posting
+	//
+	// This endpoint enables integrations to create job postings in your Lever account. The
+	// perform_as parameter is required; the posting will be created on behalf of that user.
+	CreatePosting(ctx context.Context, req *CreatePostingRequest) (*CreatePostingResponse, error)
+
+	// Update a posting
+	//
+	// When you update a posting, Lever expects you to send the entire resource. Every field will
+	// be overwritten by the body of the request. If you don't include a field, it will be deleted
+	// or reset to its default. The perform_as parameter is required.
+	UpdatePosting(ctx context.Context, req *UpdatePostingRequest) (*UpdatePostingResponse, error)
+
+	// Retrieve posting application questions
+	//
+	// Returns the application form for a posting, including the personal information fields,
+	// links, custom questions and EEO questions a candidate is asked when applying.
+	GetPostingForm(ctx context.Context, req *GetPostingFormRequest) (*GetPostingFormResponse, error)
+
+	// Apply to a posting
+	//
+	// Submits an application to a posting on behalf of a candidate. Use
+	// [ApplyToPostingRequest.Validate] to check the answers against the posting's application form
+	// before submitting.
+	ApplyToPosting(ctx context.Context, req *ApplyToPostingRequest) (*ApplyToPostingResponse, error)
+}
+
+// Parameters for retrieving a single posting.
+type GetPostingRequest struct {
+	BaseRequest
+
+	// The posting id. This is required.
+	PostingID string
+}
+
+// Create a new GetPostingRequest with the required fields.
+func NewGetPostingRequest(postingID string) *GetPostingRequest {
+	return &GetPostingRequest{
+		PostingID: postingID,
+	}
+}
+
+func (r *GetPostingRequest) GetPath() string {
+	return fmt.Sprintf("postings/%s", url.PathEscape(r.PostingID))
+}
+
+// Response for retrieving a single posting; returned to client users.
+type GetPostingResponse struct {
+	BaseResponse
+
+	// The posting record.
+	Posting *model.Posting `json:"data"`
+}
+
+// JSON response type for retrieving a single posting, with some field types dynamically determined.
+type getPostingResponseJSON struct {
+	BaseResponse
+
+	// The posting record.
+	Posting *multimodel.Posting `json:"data"`
+}
+
+// Parameters for listing postings.
+type ListPostingsRequest struct {
+	BaseListRequest
+
+	// If specified, filter postings by state. Results will include postings in any of the
+	// specified states: published, internal, closed, draft, pending, rejected.
+	States []string
+
+	// If specified, filter postings by distribution channel. Results will include postings that
+	// are published to any of the specified channels: public, internal.
+	DistributionChannels []string
+
+	// If specified, filter postings by team.
+	Teams []string
+
+	// If specified, filter postings by department.
+	Departments []string
+
+	// If specified, filter postings by location.
+	Locations []string
+
+	// If specified, filter postings by commitment (e.g. Full-time, Part-time, Internship).
+	Commitments []string
+
+	// If specified, filter postings by level.
+	// WARNING: This field is deprecated but maintained for backwards compatibility.
+	Levels []string
+
+	// Filter postings by the timestamp they were last updated. If only UpdatedAtStart is
+	// specified, all postings updated from that timestamp (inclusive) to the present will be
+	// included. If only UpdatedAtEnd is specified, all postings updated before that timestamp
+	// (inclusive) are included.
+	UpdatedAtStart *int64
+	UpdatedAtEnd   *int64
+}
+
+// Create a new ListPostingsRequest with the required fields.
+func NewListPostingsRequest() *ListPostingsRequest {
+	return &ListPostingsRequest{}
+}
+
+func (r *ListPostingsRequest) GetPath() string {
+	return "postings"
+}
+
+func (r *ListPostingsRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseListRequest.AddAPIQueryParams(query)
+
+	for _, state := range r.States {
+		query.Add(paramState, state)
+	}
+
+	for _, distributionChannel := range r.DistributionChannels {
+		query.Add(paramDistributionChannel, distributionChannel)
+	}
+
+	for _, team := range r.Teams {
+		query.Add(paramTeam, team)
+	}
+
+	for _, department := range r.Departments {
+		query.Add(paramDepartment, department)
+	}
+
+	for _, location := range r.Locations {
+		query.Add(paramLocation, location)
+	}
+
+	for _, commitment := range r.Commitments {
+		query.Add(paramCommitment, commitment)
+	}
+
+	for _, level := range r.Levels {
+		query.Add(paramLevel, level)
+	}
+
+	if r.UpdatedAtStart != nil {
+		query.Add(paramUpdatedAtStart, fmt.Sprint(*r.UpdatedAtStart))
+	}
+
+	if r.UpdatedAtEnd != nil {
+		query.Add(paramUpdatedAtEnd, fmt.Sprint(*r.UpdatedAtEnd))
+	}
+}
+
+// Response for listing postings; returned to client users.
+type ListPostingsResponse struct {
+	BaseListResponse
+
+	// The posting records.
+	Postings []model.Posting `json:"data"`
+}
+
+// JSON response type for listing postings, with some field types dynamically determined.
+type listPostingsResponseJSON struct {
+	BaseListResponse
+
+	// The posting records.
+	Postings []multimodel.Posting `json:"data"`
+}
+
+// JSON body for the posting create request. Unset fields are omitted so that Lever applies its
+// defaults.
+type postingRequestBody struct {
+	Text                 string                   `json:"text,omitempty"`
+	State                string                   `json:"state,omitempty"`
+	DistributionChannels []string                 `json:"distributionChannels,omitempty"`
+	Confidentiality      string                   `json:"confidentiality,omitempty"`
+	OwnerID              string                   `json:"owner,omitempty"`
+	HiringManagerID      string                   `json:"hiringManager,omitempty"`
+	Categories           *model.PostingCategories `json:"categories,omitempty"`
+	Tags                 []string                 `json:"tags,omitempty"`
+	Content              *model.PostingContent    `json:"content,omitempty"`
+	FollowerIDs          []string                 `json:"followers,omitempty"`
+	RequisitionCodes     []string                 `json:"requisitionCodes,omitempty"`
+	WorkplaceType        string                   `json:"workplaceType,omitempty"`
+}
+
+// JSON body for the posting update request. Lever replaces the whole posting on update, so
+// clearable fields are always sent: empty lists as [] and unset users as null.
+type updatePostingRequestBody struct {
+	Text                 string                   `json:"text"`
+	State                string                   `json:"state,omitempty"`
+	DistributionChannels []string                 `json:"distributionChannels"`
+	OwnerID              *string                  `json:"owner"`
+	HiringManagerID      *string                  `json:"hiringManager"`
+	Categories           *model.PostingCategories `json:"categories"`
+	Tags                 []string                 `json:"tags"`
+	Content              *model.PostingContent    `json:"content"`
+	FollowerIDs          []string                 `json:"followers"`
+	RequisitionCodes     []string                 `json:"requisitionCodes"`
+	WorkplaceType        string                   `json:"workplaceType,omitempty"`
+}
+
+// Parameters for creating a posting.
+type CreatePostingRequest struct {
+	BaseRequest
+
+	// Perform this create on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// Title of the job posting. This is required.
+	Text string
+
+	// Posting's current status. Can be one of the following values: published, internal, closed,
+	// draft, pending, rejected. If unspecified, the posting is created as a draft.
+	State string
+
+	// Array of job sites that a published posting appears on: public, internal.
+	DistributionChannels []string
+
+	// The confidentiality of the posting. Can be one of the following values: non-confidential,
+	// confidential. It is not possible to update a posting's confidentiality after creation.
+	Confidentiality string
+
+	// The user ID of the posting owner. If not specified, the posting owner defaults to the
+	// PerformAsID user.
+	OwnerID string
+
+	// The user ID of the hiring manager for the job posting.
+	HiringManagerID string
+
+	// An object containing the tags of various categories.
+	Categories *model.PostingCategories
+
+	// An array of additional posting tags.
+	Tags []string
+
+	// Content of the job posting.
+	Content *model.PostingContent
+
+	// An array of user IDs that should be added as followers to this posting.
+	FollowerIDs []string
+
+	// Array of requisition codes associated with this posting.
+	RequisitionCodes []string
+
+	// Workplace type of this posting. Can be one of the following values: onsite, remote, hybrid
+	WorkplaceType string
+}
+
+// Create a new CreatePostingRequest with the required fields.
+func NewCreatePostingRequest(performAsID, text string) *CreatePostingRequest {
+	return &CreatePostingRequest{
+		PerformAsID: performAsID,
+		Text:        text,
+	}
+}
+
+func (r *CreatePostingRequest) GetPath() string {
+	return "postings"
+}
+
+func (r *CreatePostingRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreatePostingRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *CreatePostingRequest) GetBody() (io.Reader, error) {
+	body := postingRequestBody{
+		Text:                 r.Text,
+		State:                r.State,
+		DistributionChannels: r.DistributionChannels,
+		Confidentiality:      r.Confidentiality,
+		OwnerID:              r.OwnerID,
+		HiringManagerID:      r.HiringManagerID,
+		Categories:           r.Categories,
+		Tags:                 r.Tags,
+		Content:              r.Content,
+		FollowerIDs:          r.FollowerIDs,
+		RequisitionCodes:     r.RequisitionCodes,
+		WorkplaceType:        r.WorkplaceType,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Response for creating a posting; returned to client users.
+type CreatePostingResponse struct {
+	BaseResponse
+
+	// The posting record.
+	Posting *model.Posting `json:"data"`
+}
+
+// JSON response type for creating a posting, with some field types dynamically determined.
+type createPostingResponseJSON struct {
+	BaseResponse
+
+	// The posting record.
+	Posting *multimodel.Posting `json:"data"`
+}
+
+// Parameters for updating a posting.
+type UpdatePostingRequest struct {
+	BaseRequest
+
+	// The posting id. This is required.
+	PostingID string
+
+	// Perform this update on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// Title of the job posting. This is required.
+	Text string
+
+	// Posting's current status. Can be one of the following values: published, internal, closed,
+	// draft, pending, rejected.
+	State string
+
+	// Array of job sites that a published posting appears on: public, internal.
+	DistributionChannels []string
+
+	// The user ID of the posting owner.
+	OwnerID string
+
+	// The user ID of the hiring manager for the job posting.
+	HiringManagerID string
+
+	// An object containing the tags of various categories.
+	Categories *model.PostingCategories
+
+	// An array of additional posting tags.
+	Tags []string
+
+	// Content of the job posting.
+	Content *model.PostingContent
+
+	// An array of user IDs of the followers of this posting.
+	FollowerIDs []string
+
+	// Array of requisition codes associated with this posting.
+	RequisitionCodes []string
+
+	// Workplace type of this posting. Can be one of the following values: onsite, remote, hybrid
+	WorkplaceType string
+}
+
+// Create a new UpdatePostingRequest with the required fields.
+func NewUpdatePostingRequest(performAsID, postingID, text string) *UpdatePostingRequest {
+	return &UpdatePostingRequest{
+		PostingID:   postingID,
+		PerformAsID: performAsID,
+		Text:        text,
+	}
+}
+
+// Create a new UpdatePostingRequest based on an existing Posting struct.
+func NewUpdatePostingRequestFromPosting(performAsID string, posting *model.Posting) *UpdatePostingRequest {
+	return &UpdatePostingRequest{
+		PostingID:            posting.ID,
+		PerformAsID:          performAsID,
+		Text:                 posting.Text,
+		State:                posting.State,
+		DistributionChannels: posting.DistributionChannels,
+		OwnerID:              posting.OwnerID,
+		HiringManagerID:      posting.HiringManagerID,
+		Categories:           posting.Categories,
+		Tags:                 posting.Tags,
+		Content:              posting.Content,
+		FollowerIDs:          posting.FollowerIds,
+		RequisitionCodes:     posting.RequisitionCodes,
+		WorkplaceType:        posting.WorkplaceType,
+	}
+}
+
+func (r *UpdatePostingRequest) GetPath() string {
+	return fmt.Sprintf("postings/%s", url.PathEscape(r.PostingID))
+}
+
+func (r *UpdatePostingRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *UpdatePostingRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *UpdatePostingRequest) GetBody() (io.Reader, error) {
+	body := updatePostingRequestBody{
+		Text:                 r.Text,
+		State:                r.State,
+		DistributionChannels: emptyIfNil(r.DistributionChannels),
+		OwnerID:              nilIfEmpty(r.OwnerID),
+		HiringManagerID:      nilIfEmpty(r.HiringManagerID),
+		Categories:           r.Categories,
+		Tags:                 emptyIfNil(r.Tags),
+		Content:              r.Content,
+		FollowerIDs:          emptyIfNil(r.FollowerIDs),
+		RequisitionCodes:     emptyIfNil(r.RequisitionCodes),
+		WorkplaceType:        r.WorkplaceType,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Response for updating a posting; returned to client users.
+type UpdatePostingResponse struct {
+	BaseResponse
+
+	// The posting record.
+	Posting *model.Posting `json:"data"`
+}
+
+// JSON response type for updating a posting, with some field types dynamically determined.
+type updatePostingResponseJSON struct {
+	BaseResponse
+
+	// The posting record.
+	Posting *multimodel.Posting `json:"data"`
+}
+
+// Parameters for retrieving the application questions for a posting.
+type GetPostingFormRequest struct {
+	BaseRequest
+
+	// The posting id. This is required.
+	PostingID string
+}
+
+// Create a new GetPostingFormRequest with the required fields.
+func NewGetPostingFormRequest(postingID string) *GetPostingFormRequest {
+	return &GetPostingFormRequest{
+		PostingID: postingID,
+	}
+}
+
+func (r *GetPostingFormRequest) GetPath() string {
+	return fmt.Sprintf("postings/%s/apply", url.PathEscape(r.PostingID))
+}
+
+// Response for retrieving the application questions for a posting.
+type GetPostingFormResponse struct {
+	BaseResponse
+
+	// The posting application form.
+	PostingForm *model.PostingForm `json:"data"`
+}
+
+// Parameters for applying to a posting on behalf of a candidate.
+type ApplyToPostingRequest struct {
+	BaseRequest
+
+	// The posting id. This is required.
+	PostingID string
+
+	// If set, send a confirmation email to the candidate after the application is submitted.
+	SendConfirmationEmail bool
+
+	// Answers to the personal information fields, identified by field name (e.g. "name",
+	// "email", "phone").
+	PersonalInformation []model.PostingFormNamedAnswer
+
+	// Answers to the link fields, identified by field name (e.g. "LinkedIn", "GitHub").
+	URLs []model.PostingFormNamedAnswer
+
+	// Answers to the custom questions, identified by custom question ID.
+	CustomQuestions []model.PostingFormCustomAnswer
+
+	// Answers to the EEO questions.
+	EEOResponses *model.PostingFormEEOAnswers
+
+	// Resume file for this application.
+	ResumeFile *model.Reader
+
+	// Content type value for the multipart/form-data boundary string
+	contentType string
+}
+
+// Create a new ApplyToPostingRequest with the required fields.
+func NewApplyToPostingRequest(postingID string) *ApplyToPostingRequest {
+	return &ApplyToPostingRequest{
+		PostingID: postingID,
+	}
+}
+
+func (r *ApplyToPostingRequest) GetPath() string {
+	return fmt.Sprintf("postings/%s/apply", url.PathEscape(r.PostingID))
+}
+
+func (r *ApplyToPostingRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *ApplyToPostingRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.SendConfirmationEmail {
+		query.Add(paramSendConfirmationEmail, "true")
+	}
+}
+
+func (r *ApplyToPostingRequest) GetBody() (io.Reader, error) {
+	body, contentType := newMultipartBody(r.writeBody)
+	r.contentType = contentType
+	return body, nil
+}
+
+func (r *ApplyToPostingRequest) GetContentType() string {
+	return r.contentType
+}
+
+// writeBody writes the body of the request to the provided writer.
+func (r *ApplyToPostingRequest) writeBody(w *multipart.Writer) error {
+	for i, answer := range r.PersonalInformation {
+		if err := w.WriteField(fmt.Sprintf("personalInformation[%d][name]", i), answer.Name); err != nil {
+			return err
+		}
+
+		if err := w.WriteField(fmt.Sprintf("personalInformation[%d][value]", i), answer.Value); err != nil {
+			return err
+		}
+	}
+
+	for i, answer := range r.URLs {
+		if err := w.WriteField(fmt.Sprintf("urls[%d][name]", i), answer.Name); err != nil {
+			return err
+		}
+
+		if err := w.WriteField(fmt.Sprintf("urls[%d][value]", i), answer.Value); err != nil {
+			return err
+		}
+	}
+
+	for i, question := range r.CustomQuestions {
+		if err := w.WriteField(fmt.Sprintf("customQuestions[%d][id]", i), question.ID); err != nil {
+			return err
+		}
+
+		for j, field := range question.Fields {
+			if field.Values != nil {
+				for k, value := range field.Values {
+					if err := w.WriteField(fmt.Sprintf("customQuestions[%d][fields][%d][value][%d]", i, j, k), value); err != nil {
+						return err
+					}
+				}
+			} else if err := w.WriteField(fmt.Sprintf("customQuestions[%d][fields][%d][value]", i, j), field.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	if r.EEOResponses != nil {
+		eeoFields := []struct {
+			name  string
+			value string
+		}{
+			{"gender", r.EEOResponses.Gender},
+			{"race", r.EEOResponses.Race},
+			{"veteran", r.EEOResponses.Veteran},
+			{"disability", r.EEOResponses.Disability},
+			{"disabilitySignature", r.EEOResponses.DisabilitySignature},
+			{"disabilitySignatureDate", r.EEOResponses.DisabilitySignatureDate},
+		}
+
+		for _, field := range eeoFields {
+			if field.value == "" {
+				continue
+			}
+
+			if err := w.WriteField(fmt.Sprintf("eeoResponses[%s]", field.name), field.value); err != nil {
+				return err
+			}
+		}
+	}
+
+	if r.ResumeFile != nil {
+		if err := writeMultipartFile(w, "resume", r.ResumeFile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate the answers in this request against a posting application form, as returned by
+// [Client.GetPostingForm].
+//
+// This checks that every required field has an answer, that every answer refers to a field on
+// the form, and that answers to dropdown, multiple-choice and multiple-select fields are among
+// the field's options. All problems found are returned, joined with [errors.Join].
+func (r *ApplyToPostingRequest) Validate(form *model.PostingForm) error {
+	var errs []error
+
+	errs = append(errs, validateNamedAnswers("personal information", form.PersonalInformation, r.PersonalInformation, r.ResumeFile != nil)...)
+	errs = append(errs, validateNamedAnswers("link", form.URLs, r.URLs, false)...)
+
+	questions := make(map[string]*model.PostingFormCustomQuestion, len(form.CustomQuestions))
+	for i := range form.CustomQuestions {
+		questions[form.CustomQuestions[i].ID] = &form.CustomQuestions[i]
+	}
+
+	answers := make(map[string]*model.PostingFormCustomAnswer, len(r.CustomQuestions))
+	for i := range r.CustomQuestions {
+		answer := &r.CustomQuestions[i]
+		question, ok := questions[answer.ID]
+		if !ok {
+			errs = append(errs, fmt.Errorf("custom question %s is not on the posting form", answer.ID))
+			continue
+		}
+
+		if len(answer.Fields) > len(question.Fields) {
+			errs = append(errs, fmt.Errorf("custom question %s has %d fields, but %d answers were given", answer.ID, len(question.Fields), len(answer.Fields)))
+		}
+
+		answers[answer.ID] = answer
+	}
+
+	for _, question := range form.CustomQuestions {
+		answer := answers[question.ID]
+
+		for j := range question.Fields {
+			var fieldAnswer model.FormFieldAnswer
+			if answer != nil && j < len(answer.Fields) {
+				fieldAnswer = answer.Fields[j]
+			}
+
+			if err := validateFormFieldAnswer(&question.Fields[j], &fieldAnswer); err != nil {
+				errs = append(errs, fmt.Errorf("custom question %s: %w", question.ID, err))
+			}
+		}
+	}
+
+	if r.EEOResponses != nil {
+		var questions model.PostingFormEEOQuestions
+		if form.EEOQuestions != nil {
+			questions = *form.EEOQuestions
+		}
+
+		eeoAnswers := []struct {
+			name     string
+			question *model.FormField
+			value    string
+		}{
+			{"gender", questions.Gender, r.EEOResponses.Gender},
+			{"race", questions.Race, r.EEOResponses.Race},
+			{"veteran", questions.Veteran, r.EEOResponses.Veteran},
+			{"disability", questions.Disability, r.EEOResponses.Disability},
+			{"disabilitySignature", questions.DisabilitySignature, r.EEOResponses.DisabilitySignature},
+			{"disabilitySignatureDate", questions.DisabilitySignatureDate, r.EEOResponses.DisabilitySignatureDate},
+		}
+
+		for _, eeo := range eeoAnswers {
+			if eeo.value == "" {
+				continue
+			}
+
+			if eeo.question == nil {
+				errs = append(errs, fmt.Errorf("EEO question %s is not on the posting form", eeo.name))
+				continue
+			}
+
+			if eeo.question.HasOptions() && !eeo.question.IsOption(eeo.value) {
+				errs = append(errs, fmt.Errorf("EEO question %s: %q is not a valid option", eeo.name, eeo.value))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate answers to form fields identified by name. File fields are satisfied by hasFile.
+func validateNamedAnswers(kind string, fields []model.FormField, answers []model.PostingFormNamedAnswer, hasFile bool) []error {
+	var errs []error
+
+	fieldsByName := make(map[string]*model.FormField, len(fields))
+	for i := range fields {
+		fieldsByName[fields[i].Name] = &fields[i]
+	}
+
+	answered := make(map[string]bool, len(answers))
+	for _, answer := range answers {
+		if _, ok := fieldsByName[answer.Name]; !ok {
+			errs = append(errs, fmt.Errorf("%s field %q is not on the posting form", kind, answer.Name))
+			continue
+		}
+
+		if answer.Value != "" {
+			answered[answer.Name] = true
+		}
+	}
+
+	for _, field := range fields {
+		if !field.Required {
+			continue
+		}
+
+		if field.Type == model.FormFieldTypeFile {
+			if !hasFile {
+				errs = append(errs, fmt.Errorf("%s field %q requires a file", kind, field.Name))
+			}
+			continue
+		}
+
+		if !answered[field.Name] {
+			errs = append(errs, fmt.Errorf("%s field %q is required", kind, field.Name))
+		}
+	}
+
+	return errs
+}
+
+// Validate an answer to a single form field.
+func validateFormFieldAnswer(field *model.FormField, answer *model.FormFieldAnswer) error {
+	if answer.IsEmpty() {
+		if field.Required {
+			return fmt.Errorf("field %q is required", field.Text)
+		}
+
+		return nil
+	}
+
+	if field.Type == model.FormFieldTypeMultipleSelect {
+		if answer.Value != "" {
+			return fmt.Errorf("field %q is a multiple-select field; use Values instead of Value", field.Text)
+		}
+	} else if len(answer.Values) > 0 {
+		return fmt.Errorf("field %q accepts a single value; use Value instead of Values", field.Text)
+	}
+
+	if field.HasOptions() {
+		values := answer.Values
+		if answer.Value != "" {
+			values = []string{answer.Value}
+		}
+
+		for _, value := range values {
+			if !field.IsOption(value) {
+				return fmt.Errorf("field %q: %q is not a valid option", field.Text, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Response for applying to a posting.
+type ApplyToPostingResponse struct {
+	BaseResponse
+
+	// The result of the application.
+	Result *model.PostingApplicationResult `json:"data"`
+}
+
+// Retrieve a single posting
+func (c *Client) GetPosting(ctx context.Context, req *GetPostingRequest) (*GetPostingResponse, error) {
+	var respJSON getPostingResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var posting model.Posting
+	err := respJSON.Posting.ToModel(&posting)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetPostingResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Posting:      &posting,
+	}
+
+	return &resp, nil
+}
+
+// List all postings
+//
+// Lists all postings in your Lever account.
+func (c *Client) ListPostings(ctx context.Context, req *ListPostingsRequest) (*ListPostingsResponse, error) {
+	var respJSON listPostingsResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	postings := make([]model.Posting, len(respJSON.Postings))
+	for i := range respJSON.Postings {
+		err := respJSON.Postings[i].ToModel(&postings[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListPostingsResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		Postings:         postings,
+	}
+
+	return &resp, nil
+}
+
+// Create a posting
+//
+// This endpoint enables integrations to create job postings in your Lever account. The
+// perform_as parameter is required; the posting will be created on behalf of that user.
+func (c *Client) CreatePosting(ctx context.Context, req *CreatePostingRequest) (*CreatePostingResponse, error) {
+	var respJSON createPostingResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var posting model.Posting
+	err := respJSON.Posting.ToModel(&posting)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := CreatePostingResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Posting:      &posting,
+	}
+
+	return &resp, nil
+}
+
+// Update a posting
+//
+// When you update a posting, Lever expects you to send the entire resource. Every field will
+// be overwritten by the body of the request. If you don't include a field, it will be deleted
+// or reset to its default. The perform_as parameter is required.
+func (c *Client) UpdatePosting(ctx context.Context, req *UpdatePostingRequest) (*UpdatePostingResponse, error) {
+	var respJSON updatePostingResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var posting model.Posting
+	err := respJSON.Posting.ToModel(&posting)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := UpdatePostingResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Posting:      &posting,
+	}
+
+	return &resp, nil
+}
+
+// Retrieve posting application questions
+//
+// Returns the application form for a posting, including the personal information fields,
+// links, custom questions and EEO questions a candidate is asked when applying.
+func (c *Client) GetPostingForm(ctx context.Context, req *GetPostingFormRequest) (*GetPostingFormResponse, error) {
+	var resp GetPostingFormResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Apply to a posting
+//
+// Submits an application to a posting on behalf of a candidate. Use
+// [ApplyToPostingRequest.Validate] to check the answers against the posting's application form
+// before submitting.
+func (c *Client) ApplyToPosting(ctx context.Context, req *ApplyToPostingRequest) (*ApplyToPostingResponse, error) {
+	var resp ApplyToPostingResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
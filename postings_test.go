@@ -0,0 +1,778 @@
+package lever
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostings(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{postingCustomerSuccess}, "hasNext": true, "next": "1"}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/postings"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": []map[string]any{expandPosting(postingCustomerSuccess, "user", "owner", "hiringManager", "followers")}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/postings"),
+			testclient.ExpectQuery("offset", "1"),
+			testclient.ExpectQuery("expand", "user", "owner", "hiringManager", "followers"),
+			testclient.ExpectQuery("state", "published", "internal"),
+			testclient.ExpectQuery("distribution_channel", "public"),
+			testclient.ExpectQuery("team", "Customer Success"),
+			testclient.ExpectQuery("department", "Sales"),
+			testclient.ExpectQuery("location", "San Francisco"),
+			testclient.ExpectQuery("commitment", "Full-time"),
+			testclient.ExpectQuery("level", "Senior"),
+			testclient.ExpectQuery("updated_at_start", "0"),
+			testclient.ExpectQuery("updated_at_end", "9223372036854775807"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": expandPosting(postingCustomerSuccess, "owner")}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/postings/f2f01e16-27f8-4711-a728-7d49499795a0"),
+			testclient.ExpectQuery("expand", "owner"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Posting was not found"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/postings/00000000-0000-0000-0000-000000000000"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// List postings
+	listReq := NewListPostingsRequest()
+	listResp, err := c.ListPostings(ctx, listReq)
+
+	if ta.NoError(err) {
+		ta.True(listResp.HasNext)
+		ta.Equal("1", listResp.Next)
+		if ta.Len(listResp.Postings, 1) {
+			posting := listResp.Postings[0]
+			ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", posting.ID)
+			ta.Equal("Customer Success Manager", posting.Text)
+			ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", posting.UserID)
+			ta.Nil(posting.User)
+			ta.Len(posting.FollowerIds, 2)
+			ta.Empty(posting.Followers)
+			if ta.NotNil(posting.Categories) {
+				ta.Equal("Customer Success", posting.Categories.Team)
+			}
+		}
+	}
+
+	// Expand users and filter
+	listReq.Offset = listResp.Next
+	listReq.Expand = []string{"user", "owner", "hiringManager", "followers"}
+	listReq.States = []string{"published", "internal"}
+	listReq.DistributionChannels = []string{"public"}
+	listReq.Teams = []string{"Customer Success"}
+	listReq.Departments = []string{"Sales"}
+	listReq.Locations = []string{"San Francisco"}
+	listReq.Commitments = []string{"Full-time"}
+	listReq.Levels = []string{"Senior"}
+	listReq.UpdatedAtStart = &zeroTime
+	listReq.UpdatedAtEnd = &endTime
+	listResp, err = c.ListPostings(ctx, listReq)
+
+	if ta.NoError(err) {
+		ta.False(listResp.HasNext)
+		if ta.Len(listResp.Postings, 1) {
+			posting := listResp.Postings[0]
+			if ta.NotNil(posting.User) {
+				ta.Equal(posting.UserID, posting.User.ID)
+			}
+			if ta.NotNil(posting.Owner) {
+				ta.Equal(posting.OwnerID, posting.Owner.ID)
+			}
+			if ta.NotNil(posting.HiringManager) {
+				ta.Equal(posting.HiringManagerID, posting.HiringManager.ID)
+				ta.Equal("Monica Geller", posting.HiringManager.Name)
+			}
+			ta.Len(posting.FollowerIds, 2)
+			ta.Len(posting.Followers, 2)
+		}
+	}
+
+	// Get a posting
+	getReq := NewGetPostingRequest("f2f01e16-27f8-4711-a728-7d49499795a0")
+	getReq.Expand = []string{"owner"}
+	getResp, err := c.GetPosting(ctx, getReq)
+
+	if ta.NoError(err) && ta.NotNil(getResp.Posting) {
+		ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", getResp.Posting.ID)
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", getResp.Posting.OwnerID)
+		if ta.NotNil(getResp.Posting.Owner) {
+			ta.Equal("Rachel Green", getResp.Posting.Owner.Name)
+		}
+		ta.Nil(getResp.Posting.User)
+		ta.Equal([]string{"REQ-1"}, getResp.Posting.RequisitionCodes)
+	}
+
+	// Get a posting that does not exist
+	getReq = NewGetPostingRequest("00000000-0000-0000-0000-000000000000")
+	getResp, err = c.GetPosting(ctx, getReq)
+
+	if ta.Error(err) {
+		ta.Nil(getResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+}
+
+func TestCreateUpdatePosting(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": postingCustomerSuccess}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/postings"),
+			testclient.ExpectQuery("perform_as", "df0adaa6-172c-4cd6-8520-49b203660fe1"),
+			testclient.ExpectHeader("Content-Type", "application/json"),
+			testclient.ExpectBody(`{"text":"Customer Success Manager","state":"published","distributionChannels":["public","internal"],"owner":"ecdb6670-d9f3-4b87-8267-1cde26d1bc42","hiringManager":"022d6639-1333-419b-9635-31f93015335f","categories":{"team":"Customer Success","department":"Sales","location":"San Francisco","commitment":"Full-time"},"tags":["San Francisco"],"content":{"description":"Lever is looking for a Customer Success Manager.","lists":[{"text":"Responsibilities","content":"<li>Be awesome</li>"}]},"requisitionCodes":["REQ-1"],"workplaceType":"onsite"}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": expandPosting(postingCustomerSuccess, "hiringManager")}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/postings/f2f01e16-27f8-4711-a728-7d49499795a0"),
+			testclient.ExpectQuery("perform_as", "df0adaa6-172c-4cd6-8520-49b203660fe1"),
+			testclient.ExpectQuery("expand", "hiringManager"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusBadRequest,
+			`{"code":"BadRequestError","message":"Missing required field: text"}`,
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/postings"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// Create a posting
+	createReq := NewCreatePostingRequest("df0adaa6-172c-4cd6-8520-49b203660fe1", "Customer Success Manager")
+	createReq.State = "published"
+	createReq.DistributionChannels = []string{"public", "internal"}
+	createReq.OwnerID = "ecdb6670-d9f3-4b87-8267-1cde26d1bc42"
+	createReq.HiringManagerID = "022d6639-1333-419b-9635-31f93015335f"
+	createReq.Categories = &model.PostingCategories{
+		Team:       "Customer Success",
+		Department: "Sales",
+		Location:   "San Francisco",
+		Commitment: "Full-time",
+	}
+	createReq.Tags = []string{"San Francisco"}
+	createReq.Content = &model.PostingContent{
+		Description: "Lever is looking for a Customer Success Manager.",
+		Lists: []model.PostingContentList{
+			{Title: "Responsibilities", Content: "<li>Be awesome</li>"},
+		},
+	}
+	createReq.RequisitionCodes = []string{"REQ-1"}
+	createReq.WorkplaceType = "onsite"
+
+	createResp, err := c.CreatePosting(ctx, createReq)
+	if ta.NoError(err) && ta.NotNil(createResp.Posting) {
+		ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", createResp.Posting.ID)
+		ta.Equal("022d6639-1333-419b-9635-31f93015335f", createResp.Posting.HiringManagerID)
+		if ta.NotNil(createResp.Posting.Content) && ta.Len(createResp.Posting.Content.Lists, 1) {
+			ta.Equal("Responsibilities", createResp.Posting.Content.Lists[0].Title)
+		}
+	} else {
+		t.Fatal("Failed to create posting; cannot proceed with remaining tests")
+	}
+
+	// Update the posting we just created
+	updateReq := NewUpdatePostingRequestFromPosting("df0adaa6-172c-4cd6-8520-49b203660fe1", createResp.Posting)
+	updateReq.Expand = []string{"hiringManager"}
+	updateReq.State = "closed"
+	updateResp, err := c.UpdatePosting(ctx, updateReq)
+
+	if ta.NoError(err) && ta.NotNil(updateResp.Posting) {
+		ta.Equal("f2f01e16-27f8-4711-a728-7d49499795a0", updateResp.Posting.ID)
+		if ta.NotNil(updateResp.Posting.HiringManager) {
+			ta.Equal("Monica Geller", updateResp.Posting.HiringManager.Name)
+		}
+	}
+
+	// Create a posting without a title
+	createReq = NewCreatePostingRequest("df0adaa6-172c-4cd6-8520-49b203660fe1", "")
+	createResp, err = c.CreatePosting(ctx, createReq)
+
+	if ta.Error(err) {
+		ta.Nil(createResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("BadRequestError", leverError.Code)
+		}
+	}
+}
+
+func TestUpdatePostingClearsFields(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": postingCustomerSuccess}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/postings/f2f01e16-27f8-4711-a728-7d49499795a0"),
+			testclient.ExpectQuery("perform_as", "df0adaa6-172c-4cd6-8520-49b203660fe1"),
+			testclient.ExpectBody(`{"text":"Customer Success Manager","state":"published","distributionChannels":["public"],"owner":"ecdb6670-d9f3-4b87-8267-1cde26d1bc42","hiringManager":null,"categories":null,"tags":[],"content":null,"followers":[],"requisitionCodes":[]}`+"\n"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Clear the hiring manager, tags, followers and requisition codes
+	updateReq := NewUpdatePostingRequest("df0adaa6-172c-4cd6-8520-49b203660fe1", "f2f01e16-27f8-4711-a728-7d49499795a0", "Customer Success Manager")
+	updateReq.State = "published"
+	updateReq.DistributionChannels = []string{"public"}
+	updateReq.OwnerID = "ecdb6670-d9f3-4b87-8267-1cde26d1bc42"
+	updateReq.Tags = []string{}
+	_, err := c.UpdatePosting(ctx, updateReq)
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+func TestPostingContentListJSON(t *testing.T) {
+	ta := assert.New(t)
+
+	// Lever returns the list title in the "text" field.
+	var list model.PostingContentList
+	err := json.Unmarshal([]byte(`{"text":"Responsibilities","content":"<li>Be awesome</li>"}`), &list)
+	if ta.NoError(err) {
+		ta.Equal("Responsibilities", list.Title)
+		ta.Equal("<li>Be awesome</li>", list.Content)
+	}
+
+	encoded, err := json.Marshal(list)
+	if ta.NoError(err) {
+		ta.JSONEq(`{"text":"Responsibilities","content":"<li>Be awesome</li>"}`, string(encoded))
+	}
+}
+
+func TestPostingContentListRoundTrip(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			postingInfrastructureEngineerJSON,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/postings/f2f01e16-27f8-4711-a728-7d49499795a0"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			postingInfrastructureEngineerJSON,
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/postings/f2f01e16-27f8-4711-a728-7d49499795a0"),
+			testclient.ExpectQuery("perform_as", "df0adaa6-172c-4cd6-8520-49b203660fe1"),
+			testclient.ExpectBody(`{"text":"Infrastructure Engineer","state":"published","distributionChannels":["public","internal"],"owner":"df0adaa6-172c-4cd6-8520-49b203660fe1","hiringManager":"df0adaa6-172c-4cd6-8520-49b203660fe1","categories":{"team":"Platform","department":"Engineering","location":"San Francisco","commitment":"Full-time","level":"Senior"},"tags":["Platform"],"content":{"description":"Lever is looking for an Infrastructure Engineer to join our Platform team.","descriptionHtml":"<div>Lever is looking for an Infrastructure Engineer to join our Platform team.</div>","lists":[{"text":"Requirements","content":"<li>5+ years running production systems</li><li>Experience with Go</li>"},{"text":"Responsibilities","content":"<li>Keep Lever fast and reliable</li>"}],"closing":"Apply today!","closingHtml":"<div>Apply today!</div>"},"followers":["df0adaa6-172c-4cd6-8520-49b203660fe1"],"requisitionCodes":[],"workplaceType":"onsite"}`+"\n"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Lever returns list titles in the "text" field.
+	getResp, err := c.GetPosting(ctx, NewGetPostingRequest("f2f01e16-27f8-4711-a728-7d49499795a0"))
+	if !ta.NoError(err) || !ta.NotNil(getResp.Posting.Content) {
+		return
+	}
+
+	lists := getResp.Posting.Content.Lists
+	if ta.Len(lists, 2) {
+		ta.Equal("Requirements", lists[0].Title)
+		ta.Equal("<li>5+ years running production systems</li><li>Experience with Go</li>", lists[0].Content)
+		ta.Equal("Responsibilities", lists[1].Title)
+	}
+
+	// Sending the posting back unchanged writes the list titles to the same field.
+	updateReq := NewUpdatePostingRequestFromPosting("df0adaa6-172c-4cd6-8520-49b203660fe1", getResp.Posting)
+	_, err = c.UpdatePosting(ctx, updateReq)
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+func TestPostingForm(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			postingFormJSON,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/postings/f2f01e16-27f8-4711-a728-7d49499795a0/apply"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			`{"data":{"applicationId":"cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"}}`,
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/postings/f2f01e16-27f8-4711-a728-7d49499795a0/apply"),
+			testclient.ExpectQuery("send_confirmation_email", "true"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Get the application form
+	formReq := NewGetPostingFormRequest("f2f01e16-27f8-4711-a728-7d49499795a0")
+	formResp, err := c.GetPostingForm(ctx, formReq)
+
+	if ta.NoError(err) && ta.NotNil(formResp.PostingForm) {
+		form := formResp.PostingForm
+		ta.Len(form.PersonalInformation, 3)
+		ta.Len(form.URLs, 1)
+		if ta.Len(form.CustomQuestions, 1) && ta.Len(form.CustomQuestions[0].Fields, 2) {
+			field := form.CustomQuestions[0].Fields[0]
+			ta.Equal(model.FormFieldTypeMultipleChoice, field.Type)
+			ta.True(field.Required)
+			ta.Len(field.Options, 2)
+		}
+		if ta.NotNil(form.EEOQuestions) && ta.NotNil(form.EEOQuestions.Gender) {
+			ta.Len(form.EEOQuestions.Gender.Options, 3)
+		}
+	} else {
+		t.Fatal("Failed to get posting form; cannot proceed with remaining tests")
+	}
+
+	form := formResp.PostingForm
+
+	// An empty application is missing required fields.
+	applyReq := NewApplyToPostingRequest("f2f01e16-27f8-4711-a728-7d49499795a0")
+	err = applyReq.Validate(form)
+	if ta.Error(err) {
+		ta.Contains(err.Error(), `personal information field "name" is required`)
+		ta.Contains(err.Error(), `personal information field "resume" requires a file`)
+		ta.Contains(err.Error(), `field "Are you authorized to work in the US?" is required`)
+	}
+
+	// Invalid options and unknown fields are rejected.
+	applyReq.PersonalInformation = []model.PostingFormNamedAnswer{
+		{Name: "name", Value: "Shane Smith"},
+		{Name: "email", Value: "shane@exampleq3.com"},
+		{Name: "favoriteColor", Value: "blue"},
+	}
+	applyReq.CustomQuestions = []model.PostingFormCustomAnswer{
+		{
+			ID: "2a9a5d54-dbb8-4d8b-9f1d-0ba0d5e1c4a6",
+			Fields: []model.FormFieldAnswer{
+				{Value: "Maybe"},
+				{Value: "Go", Values: []string{"Go"}},
+			},
+		},
+		{ID: "00000000-0000-0000-0000-000000000000"},
+	}
+	applyReq.EEOResponses = &model.PostingFormEEOAnswers{Gender: "Unknown", Race: "Decline to self-identify"}
+	applyReq.ResumeFile = &model.Reader{
+		Name:     "resume.txt",
+		Contents: io.NopCloser(strings.NewReader("Shane Smith\nCustomer Success Manager")),
+	}
+
+	err = applyReq.Validate(form)
+	if ta.Error(err) {
+		ta.Contains(err.Error(), `personal information field "favoriteColor" is not on the posting form`)
+		ta.Contains(err.Error(), `"Maybe" is not a valid option`)
+		ta.Contains(err.Error(), `use Values instead of Value`)
+		ta.Contains(err.Error(), `custom question 00000000-0000-0000-0000-000000000000 is not on the posting form`)
+		ta.Contains(err.Error(), `EEO question gender: "Unknown" is not a valid option`)
+		ta.Contains(err.Error(), `EEO question race is not on the posting form`)
+		ta.NotContains(err.Error(), "is required")
+	}
+
+	// A valid application.
+	applyReq.PersonalInformation = applyReq.PersonalInformation[:2]
+	applyReq.CustomQuestions = []model.PostingFormCustomAnswer{
+		{
+			ID: "2a9a5d54-dbb8-4d8b-9f1d-0ba0d5e1c4a6",
+			Fields: []model.FormFieldAnswer{
+				{Value: "Yes"},
+				{Values: []string{"Go", "Python"}},
+			},
+		},
+	}
+	applyReq.EEOResponses = &model.PostingFormEEOAnswers{Gender: "Decline to self-identify"}
+	applyReq.SendConfirmationEmail = true
+	ta.NoError(applyReq.Validate(form))
+
+	applyResp, err := c.ApplyToPosting(ctx, applyReq)
+	if ta.NoError(err) && ta.NotNil(applyResp.Result) {
+		ta.Equal("cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa", applyResp.Result.ApplicationID)
+	}
+}
+
+// failingReader returns an error once its contents are exhausted.
+type failingReader struct {
+	io.Reader
+}
+
+func (r failingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err == io.EOF {
+		err = errors.New("disk read failed")
+	}
+
+	return n, err
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestApplyToPostingWriteError(t *testing.T) {
+	ta := assert.New(t)
+
+	// A failed field write is returned rather than producing a truncated body.
+	req := NewApplyToPostingRequest("f2f01e16-27f8-4711-a728-7d49499795a0")
+	req.PersonalInformation = []model.PostingFormNamedAnswer{{Name: "name", Value: "Shane Smith"}}
+	ta.EqualError(req.writeBody(multipart.NewWriter(failingWriter{})), "connection reset")
+
+	req = NewApplyToPostingRequest("f2f01e16-27f8-4711-a728-7d49499795a0")
+	req.EEOResponses = &model.PostingFormEEOAnswers{Gender: "Decline to self-identify"}
+	ta.EqualError(req.writeBody(multipart.NewWriter(failingWriter{})), "connection reset")
+}
+
+// TestApplyToPostingServer sends the multipart body over a real HTTP connection, which only
+// completes if the body is terminated with EOF.
+func TestApplyToPostingServer(t *testing.T) {
+	ta := assert.New(t)
+
+	var received *multipart.Form
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err == nil {
+			received, err = multipart.NewReader(bytes.NewReader(body), params["boundary"]).ReadForm(1 << 20)
+		}
+
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"applicationId":"cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa"}}`))
+	}))
+
+	// Fail instead of hanging if the body is never terminated.
+	server.Config.ReadTimeout = 5 * time.Second
+	server.Start()
+	defer server.Close()
+	defer server.CloseClientConnections()
+
+	c := NewClient(WithBaseURL(server.URL))
+
+	// apply sends the request, failing the test if it does not complete.
+	apply := func(req *ApplyToPostingRequest) (*ApplyToPostingResponse, error) {
+		type result struct {
+			resp *ApplyToPostingResponse
+			err  error
+		}
+
+		done := make(chan result, 1)
+		go func() {
+			resp, err := c.ApplyToPosting(context.Background(), req)
+			done <- result{resp, err}
+		}()
+
+		select {
+		case r := <-done:
+			return r.resp, r.err
+		case <-time.After(10 * time.Second):
+			t.Fatal("ApplyToPosting did not complete")
+			return nil, nil
+		}
+	}
+
+	req := NewApplyToPostingRequest("f2f01e16-27f8-4711-a728-7d49499795a0")
+	req.PersonalInformation = []model.PostingFormNamedAnswer{{Name: "name", Value: "Shane Smith"}}
+	req.ResumeFile = &model.Reader{
+		Name:     "resume.txt",
+		Contents: io.NopCloser(strings.NewReader("Shane Smith")),
+	}
+
+	resp, err := apply(req)
+	if ta.NoError(err) && ta.NotNil(resp.Result) {
+		ta.Equal("cdb4ff13-f7aa-49b0-b6ec-eb4617009cfa", resp.Result.ApplicationID)
+	}
+
+	if ta.NotNil(received) {
+		ta.Equal([]string{"Shane Smith"}, received.Value["personalInformation[0][value]"])
+		ta.Len(received.File["resume"], 1)
+	}
+
+	// Errors reading the resume are returned instead of leaving the request hanging
+	req.ResumeFile = &model.Reader{
+		Name:     "resume.txt",
+		Contents: io.NopCloser(failingReader{strings.NewReader("Shane Smith")}),
+	}
+
+	_, err = apply(req)
+	ta.Error(err)
+}
+
+func TestApplyToPostingBody(t *testing.T) {
+	ta := assert.New(t)
+
+	req := NewApplyToPostingRequest("f2f01e16-27f8-4711-a728-7d49499795a0")
+	req.PersonalInformation = []model.PostingFormNamedAnswer{{Name: "name", Value: "Shane Smith"}}
+	req.URLs = []model.PostingFormNamedAnswer{{Name: "LinkedIn", Value: "https://linkedin.com/in/shane"}}
+	req.CustomQuestions = []model.PostingFormCustomAnswer{
+		{
+			ID: "2a9a5d54-dbb8-4d8b-9f1d-0ba0d5e1c4a6",
+			Fields: []model.FormFieldAnswer{
+				{Value: "Yes"},
+				{Values: []string{"Go", "Python"}},
+			},
+		},
+	}
+	req.EEOResponses = &model.PostingFormEEOAnswers{Gender: "Decline to self-identify"}
+	req.ResumeFile = &model.Reader{
+		Name:     "resume.txt",
+		Contents: io.NopCloser(strings.NewReader("Shane Smith")),
+	}
+
+	body, err := req.GetBody()
+	if !ta.NoError(err) {
+		return
+	}
+
+	_, params, err := mime.ParseMediaType(req.GetContentType())
+	if !ta.NoError(err) {
+		return
+	}
+
+	form, err := multipart.NewReader(body, params["boundary"]).ReadForm(1 << 20)
+	if !ta.NoError(err) {
+		return
+	}
+
+	ta.Equal([]string{"name"}, form.Value["personalInformation[0][name]"])
+	ta.Equal([]string{"Shane Smith"}, form.Value["personalInformation[0][value]"])
+	ta.Equal([]string{"LinkedIn"}, form.Value["urls[0][name]"])
+	ta.Equal([]string{"2a9a5d54-dbb8-4d8b-9f1d-0ba0d5e1c4a6"}, form.Value["customQuestions[0][id]"])
+	ta.Equal([]string{"Yes"}, form.Value["customQuestions[0][fields][0][value]"])
+	ta.Equal([]string{"Python"}, form.Value["customQuestions[0][fields][1][value][1]"])
+	ta.Equal([]string{"Decline to self-identify"}, form.Value["eeoResponses[gender]"])
+	ta.NotContains(form.Value, "eeoResponses[race]")
+	if ta.Len(form.File["resume"], 1) {
+		ta.Equal("resume.txt", form.File["resume"][0].Filename)
+		ta.Equal("text/plain; charset=utf-8", form.File["resume"][0].Header.Get("Content-Type"))
+	}
+}
+
+// A posting as returned by Lever, in the shape of the example in the Lever API documentation.
+const postingInfrastructureEngineerJSON = `{
+	"data": {
+		"id": "f2f01e16-27f8-4711-a728-7d49499795a0",
+		"text": "Infrastructure Engineer",
+		"createdAt": 1423187881576,
+		"updatedAt": 1423187881576,
+		"user": "df0adaa6-172c-4cd6-8520-49b203660fe1",
+		"owner": "df0adaa6-172c-4cd6-8520-49b203660fe1",
+		"hiringManager": "df0adaa6-172c-4cd6-8520-49b203660fe1",
+		"confidentiality": "non-confidential",
+		"categories": {
+			"team": "Platform",
+			"department": "Engineering",
+			"location": "San Francisco",
+			"commitment": "Full-time",
+			"level": "Senior"
+		},
+		"content": {
+			"description": "Lever is looking for an Infrastructure Engineer to join our Platform team.",
+			"descriptionHtml": "<div>Lever is looking for an Infrastructure Engineer to join our Platform team.</div>",
+			"lists": [
+				{
+					"text": "Requirements",
+					"content": "<li>5+ years running production systems</li><li>Experience with Go</li>"
+				},
+				{
+					"text": "Responsibilities",
+					"content": "<li>Keep Lever fast and reliable</li>"
+				}
+			],
+			"closing": "Apply today!",
+			"closingHtml": "<div>Apply today!</div>"
+		},
+		"country": "US",
+		"followers": ["df0adaa6-172c-4cd6-8520-49b203660fe1"],
+		"tags": ["Platform"],
+		"state": "published",
+		"distributionChannels": ["public", "internal"],
+		"reqCode": "",
+		"requisitionCodes": [],
+		"urls": {
+			"list": "https://jobs.lever.co/example",
+			"show": "https://jobs.lever.co/example/f2f01e16-27f8-4711-a728-7d49499795a0",
+			"apply": "https://jobs.lever.co/example/f2f01e16-27f8-4711-a728-7d49499795a0/apply"
+		},
+		"workplaceType": "onsite"
+	}
+}`
+
+const postingFormJSON = `{
+	"data": {
+		"personalInformation": [
+			{"type": "text", "name": "name", "text": "Full name", "required": true},
+			{"type": "text", "name": "email", "text": "Email", "required": true},
+			{"type": "file", "name": "resume", "text": "Resume/CV", "required": true}
+		],
+		"urls": [
+			{"type": "text", "name": "LinkedIn", "text": "LinkedIn URL", "required": false}
+		],
+		"customQuestions": [
+			{
+				"id": "2a9a5d54-dbb8-4d8b-9f1d-0ba0d5e1c4a6",
+				"text": "Work authorization",
+				"fields": [
+					{
+						"type": "multiple-choice",
+						"text": "Are you authorized to work in the US?",
+						"required": true,
+						"options": [{"text": "Yes"}, {"text": "No"}]
+					},
+					{
+						"type": "multiple-select",
+						"text": "Which languages do you know?",
+						"required": false,
+						"options": [{"text": "Go"}, {"text": "Python"}, {"text": "Rust"}]
+					}
+				]
+			}
+		],
+		"eeoQuestions": {
+			"gender": {
+				"type": "dropdown",
+				"text": "Gender",
+				"required": false,
+				"options": [{"text": "Female"}, {"text": "Male"}, {"text": "Decline to self-identify"}]
+			}
+		}
+	}
+}`
+
+// expandPosting expands the specified fields in the posting data.
+func expandPosting(orig map[string]any, fields ...string) map[string]any {
+	expanded := make(map[string]any)
+	for k, v := range orig {
+		expanded[k] = v
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "followers":
+			expanded["followers"] = expandUsers(expanded["followers"].([]string))
+
+		case "user", "owner", "hiringManager":
+			expanded[field] = expandUser(expanded[field].(string))
+		}
+	}
+
+	return expanded
+}
+
+var postingCustomerSuccess = map[string]any{
+	"id":                   "f2f01e16-27f8-4711-a728-7d49499795a0",
+	"text":                 "Customer Success Manager",
+	"createdAt":            1407779500465,
+	"updatedAt":            1407779500465,
+	"state":                "published",
+	"distributionChannels": []string{"public", "internal"},
+	"confidentiality":      "non-confidential",
+	"user":                 "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"owner":                "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+	"hiringManager":        "022d6639-1333-419b-9635-31f93015335f",
+	"categories": map[string]any{
+		"team":         "Customer Success",
+		"department":   "Sales",
+		"location":     "San Francisco",
+		"allLocations": []string{"San Francisco"},
+		"commitment":   "Full-time",
+		"level":        "Senior",
+	},
+	"tags": []string{"San Francisco", "Customer Success"},
+	"content": map[string]any{
+		"description":     "Lever is looking for a Customer Success Manager.",
+		"descriptionHtml": "<div>Lever is looking for a Customer Success Manager.</div>",
+		"lists": []map[string]any{
+			{
+				"text":    "Responsibilities",
+				"content": "<li>Be awesome</li>",
+			},
+		},
+		"closing":     "Apply today!",
+		"closingHtml": "<div>Apply today!</div>",
+	},
+	"country":          "US",
+	"followers":        []string{"df0adaa6-172c-4cd6-8520-49b203660fe1", "ecdb6670-d9f3-4b87-8267-1cde26d1bc42"},
+	"requisitionCodes": []string{"REQ-1"},
+	"urls": map[string]any{
+		"list":  "https://jobs.lever.co/example",
+		"show":  "https://jobs.lever.co/example/f2f01e16-27f8-4711-a728-7d49499795a0",
+		"apply": "https://jobs.lever.co/example/f2f01e16-27f8-4711-a728-7d49499795a0/apply",
+	},
+	"workplaceType": "onsite",
+}
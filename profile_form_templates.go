@@ -0,0 +1,318 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever profile form templates client interface
+type ProfileFormTemplatesClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single profile form template
+	GetProfileFormTemplate(ctx context.Context, req *GetProfileFormTemplateRequest) (*GetProfileFormTemplateResponse, error)
+
+	// List all profile form templates
+	//
+	// Lists all active profile form templates in your Lever account.
+	ListProfileFormTemplates(ctx context.Context, req *ListProfileFormTemplatesRequest) (*ListProfileFormTemplatesResponse, error)
+
+	// Create a profile form template
+	//
+	// Templates created via the API cannot be edited in the Lever application.
+	CreateProfileFormTemplate(ctx context.Context, req *CreateProfileFormTemplateRequest) (*CreateProfileFormTemplateResponse, error)
+
+	// Update a profile form template
+	//
+	// This replaces the template; all fields must be specified. Only templates created via the API
+	// can be updated.
+	UpdateProfileFormTemplate(ctx context.Context, req *UpdateProfileFormTemplateRequest) (*UpdateProfileFormTemplateResponse, error)
+
+	// Delete a profile form template
+	//
+	// Only templates created via the API can be deleted.
+	DeleteProfileFormTemplate(ctx context.Context, req *DeleteProfileFormTemplateRequest) (*DeleteProfileFormTemplateResponse, error)
+}
+
+// Parameters for retrieving a single profile form template.
+type GetProfileFormTemplateRequest struct {
+	BaseRequest
+
+	// The profile form template id. This is required.
+	ProfileFormTemplateID string
+}
+
+// Create a new GetProfileFormTemplateRequest with the required fields.
+func NewGetProfileFormTemplateRequest(profileFormTemplateID string) *GetProfileFormTemplateRequest {
+	return &GetProfileFormTemplateRequest{
+		ProfileFormTemplateID: profileFormTemplateID,
+	}
+}
+
+func (r *GetProfileFormTemplateRequest) GetPath() string {
+	return fmt.Sprintf("form_templates/%s", url.PathEscape(r.ProfileFormTemplateID))
+}
+
+// Response for retrieving a single profile form template.
+type GetProfileFormTemplateResponse struct {
+	BaseResponse
+
+	// The profile form template record.
+	ProfileFormTemplate *model.ProfileFormTemplate `json:"data"`
+}
+
+// Parameters for listing profile form templates.
+type ListProfileFormTemplatesRequest struct {
+	BaseListRequest
+}
+
+// Create a new ListProfileFormTemplatesRequest with the required fields.
+func NewListProfileFormTemplatesRequest() *ListProfileFormTemplatesRequest {
+	return &ListProfileFormTemplatesRequest{}
+}
+
+func (r *ListProfileFormTemplatesRequest) GetPath() string {
+	return "form_templates"
+}
+
+// Response for listing profile form templates.
+type ListProfileFormTemplatesResponse struct {
+	BaseListResponse
+
+	// The profile form template records.
+	ProfileFormTemplates []model.ProfileFormTemplate `json:"data"`
+}
+
+// Fields common to profile form template create and update requests.
+type ProfileFormTemplateFields struct {
+	// Template title. This is required.
+	Text string
+
+	// Template instructions.
+	Instructions string
+
+	// The fields in the template. At least one field is required. Each field must have a type and
+	// text, and dropdown, multiple-choice and multiple-select fields must have options.
+	Fields []model.FormField
+}
+
+// Check that the required profile form template fields are present. All problems found are
+// returned, joined with [errors.Join].
+func (f *ProfileFormTemplateFields) Validate() error {
+	var errs []error
+
+	if f.Text == "" {
+		errs = append(errs, errors.New("text is required"))
+	}
+
+	if len(f.Fields) == 0 {
+		errs = append(errs, errors.New("at least one field is required"))
+	}
+
+	errs = append(errs, validateTemplateFormFields(f.Fields)...)
+
+	return errors.Join(errs...)
+}
+
+// JSON body for the profile form template create and update requests.
+type profileFormTemplateRequestBody struct {
+	Text         string            `json:"text"`
+	Instructions string            `json:"instructions,omitempty"`
+	Fields       []model.FormField `json:"fields"`
+}
+
+// Encode the profile form template fields as a JSON request body.
+func (f *ProfileFormTemplateFields) encode() (io.Reader, error) {
+	body := profileFormTemplateRequestBody{
+		Text:         f.Text,
+		Instructions: f.Instructions,
+		Fields:       f.Fields,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Parameters for creating a profile form template.
+type CreateProfileFormTemplateRequest struct {
+	BaseRequest
+	ProfileFormTemplateFields
+}
+
+// Create a new CreateProfileFormTemplateRequest with the required fields.
+func NewCreateProfileFormTemplateRequest(text string, fields []model.FormField) *CreateProfileFormTemplateRequest {
+	return &CreateProfileFormTemplateRequest{
+		ProfileFormTemplateFields: ProfileFormTemplateFields{
+			Text:   text,
+			Fields: fields,
+		},
+	}
+}
+
+func (r *CreateProfileFormTemplateRequest) GetPath() string {
+	return "form_templates"
+}
+
+func (r *CreateProfileFormTemplateRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreateProfileFormTemplateRequest) GetBody() (io.Reader, error) {
+	return r.ProfileFormTemplateFields.encode()
+}
+
+// Response for creating a profile form template.
+type CreateProfileFormTemplateResponse struct {
+	BaseResponse
+
+	// The profile form template record.
+	ProfileFormTemplate *model.ProfileFormTemplate `json:"data"`
+}
+
+// Parameters for updating a profile form template.
+type UpdateProfileFormTemplateRequest struct {
+	BaseRequest
+	ProfileFormTemplateFields
+
+	// The profile form template id. This is required.
+	ProfileFormTemplateID string
+}
+
+// Create a new UpdateProfileFormTemplateRequest with the required fields.
+func NewUpdateProfileFormTemplateRequest(profileFormTemplateID, text string, fields []model.FormField) *UpdateProfileFormTemplateRequest {
+	return &UpdateProfileFormTemplateRequest{
+		ProfileFormTemplateFields: ProfileFormTemplateFields{
+			Text:   text,
+			Fields: fields,
+		},
+		ProfileFormTemplateID: profileFormTemplateID,
+	}
+}
+
+// Create a new UpdateProfileFormTemplateRequest based on an existing ProfileFormTemplate struct.
+func NewUpdateProfileFormTemplateRequestFromProfileFormTemplate(template *model.ProfileFormTemplate) *UpdateProfileFormTemplateRequest {
+	req := NewUpdateProfileFormTemplateRequest(template.ID, template.Text, template.Fields)
+	req.Instructions = template.Instructions
+
+	return req
+}
+
+func (r *UpdateProfileFormTemplateRequest) GetPath() string {
+	return fmt.Sprintf("form_templates/%s", url.PathEscape(r.ProfileFormTemplateID))
+}
+
+func (r *UpdateProfileFormTemplateRequest) GetHTTPMethod() string {
+	return http.MethodPut
+}
+
+func (r *UpdateProfileFormTemplateRequest) GetBody() (io.Reader, error) {
+	return r.ProfileFormTemplateFields.encode()
+}
+
+// Response for updating a profile form template.
+type UpdateProfileFormTemplateResponse struct {
+	BaseResponse
+
+	// The profile form template record.
+	ProfileFormTemplate *model.ProfileFormTemplate `json:"data"`
+}
+
+// Parameters for deleting a profile form template.
+type DeleteProfileFormTemplateRequest struct {
+	BaseRequest
+
+	// The profile form template id. This is required.
+	ProfileFormTemplateID string
+}
+
+// Create a new DeleteProfileFormTemplateRequest with the required fields.
+func NewDeleteProfileFormTemplateRequest(profileFormTemplateID string) *DeleteProfileFormTemplateRequest {
+	return &DeleteProfileFormTemplateRequest{
+		ProfileFormTemplateID: profileFormTemplateID,
+	}
+}
+
+func (r *DeleteProfileFormTemplateRequest) GetPath() string {
+	return fmt.Sprintf("form_templates/%s", url.PathEscape(r.ProfileFormTemplateID))
+}
+
+func (r *DeleteProfileFormTemplateRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+// Response for deleting a profile form template.
+type DeleteProfileFormTemplateResponse struct {
+	BaseResponse
+}
+
+// Retrieve a single profile form template
+func (c *Client) GetProfileFormTemplate(ctx context.Context, req *GetProfileFormTemplateRequest) (*GetProfileFormTemplateResponse, error) {
+	var resp GetProfileFormTemplateResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// List all profile form templates
+//
+// Lists all active profile form templates in your Lever account.
+func (c *Client) ListProfileFormTemplates(ctx context.Context, req *ListProfileFormTemplatesRequest) (*ListProfileFormTemplatesResponse, error) {
+	var resp ListProfileFormTemplatesResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Create a profile form template
+//
+// Templates created via the API cannot be edited in the Lever application.
+func (c *Client) CreateProfileFormTemplate(ctx context.Context, req *CreateProfileFormTemplateRequest) (*CreateProfileFormTemplateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp CreateProfileFormTemplateResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update a profile form template
+//
+// This replaces the template; all fields must be specified. Only templates created via the API can
+// be updated.
+func (c *Client) UpdateProfileFormTemplate(ctx context.Context, req *UpdateProfileFormTemplateRequest) (*UpdateProfileFormTemplateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp UpdateProfileFormTemplateResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete a profile form template
+//
+// Only templates created via the API can be deleted.
+func (c *Client) DeleteProfileFormTemplate(ctx context.Context, req *DeleteProfileFormTemplateRequest) (*DeleteProfileFormTemplateResponse, error) {
+	var resp DeleteProfileFormTemplateResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,143 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileFormTemplates(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{profileFormTemplateCompensation}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/form_templates"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": profileFormTemplateCompensation}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/form_templates/2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List profile form templates
+	listResp, err := c.ListProfileFormTemplates(ctx, NewListProfileFormTemplatesRequest())
+
+	if ta.NoError(err) && ta.Len(listResp.ProfileFormTemplates, 1) {
+		template := listResp.ProfileFormTemplates[0]
+		ta.Equal("2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c", template.ID)
+		ta.Equal("Compensation expectations", template.Text)
+		ta.Len(template.Fields, 3)
+	}
+
+	// Get a profile form template
+	getResp, err := c.GetProfileFormTemplate(ctx, NewGetProfileFormTemplateRequest("2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c"))
+
+	if ta.NoError(err) && ta.Len(getResp.ProfileFormTemplate.Fields, 3) {
+		fields := getResp.ProfileFormTemplate.Fields
+		ta.Equal(model.FormFieldTypeCurrency, fields[0].Type)
+		ta.True(fields[0].Required)
+		ta.Equal(model.FormFieldTypeYesNo, fields[1].Type)
+		ta.Equal(model.FormFieldTypeDropdown, fields[2].Type)
+		ta.True(fields[2].IsOption("H-1B"))
+		if ta.NotNil(getResp.ProfileFormTemplate.UpdatedAt) {
+			ta.Equal(int64(1417588008434), *getResp.ProfileFormTemplate.UpdatedAt)
+		}
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestCreateUpdateDeleteProfileFormTemplate(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": profileFormTemplateCompensation}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/form_templates"),
+			testclient.ExpectBody(`{"text":"Compensation expectations","fields":[{"type":"currency","text":"Expected base salary","required":true}]}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": profileFormTemplateCompensation}),
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath("/v1/form_templates/2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c"),
+			testclient.ExpectBody(`{"text":"Compensation & visa","instructions":"Complete after the recruiter screen.","fields":[{"id":"e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b","type":"currency","text":"Expected base salary","required":true},{"id":"f2a3b4c5-d6e7-4f8a-9b0c-1d2e3f4a5b6c","type":"yes-no","text":"Requires visa sponsorship"},{"id":"a3b4c5d6-e7f8-4a9b-0c1d-2e3f4a5b6c7d","type":"dropdown","text":"Current visa","options":[{"text":"H-1B"},{"text":"L-1"},{"text":"Other"}]}]}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath("/v1/form_templates/2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Invalid templates are rejected before sending
+	_, err := c.CreateProfileFormTemplate(ctx, NewCreateProfileFormTemplateRequest("Visa status", []model.FormField{
+		{Type: model.FormFieldTypeDropdown, Text: "Current visa"},
+	}))
+	ta.ErrorContains(err, "field 0: dropdown field requires options")
+
+	// Create a profile form template
+	createResp, err := c.CreateProfileFormTemplate(ctx, NewCreateProfileFormTemplateRequest("Compensation expectations", []model.FormField{
+		{Type: model.FormFieldTypeCurrency, Text: "Expected base salary", Required: true},
+	}))
+
+	if ta.NoError(err) {
+		ta.Equal("2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c", createResp.ProfileFormTemplate.ID)
+	}
+
+	// Update a profile form template from an existing record
+	template := *createResp.ProfileFormTemplate
+	template.Text = "Compensation & visa"
+	updateResp, err := c.UpdateProfileFormTemplate(ctx, NewUpdateProfileFormTemplateRequestFromProfileFormTemplate(&template))
+
+	if ta.NoError(err) {
+		ta.Equal("2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c", updateResp.ProfileFormTemplate.ID)
+	}
+
+	// Delete a profile form template
+	_, err = c.DeleteProfileFormTemplate(ctx, NewDeleteProfileFormTemplateRequest("2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c"))
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+var profileFormTemplateCompensation = map[string]any{
+	"id":           "2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c",
+	"text":         "Compensation expectations",
+	"instructions": "Complete after the recruiter screen.",
+	"createdAt":    1407460071043,
+	"updatedAt":    1417588008434,
+	"fields": []map[string]any{
+		{"id": "e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b", "type": "currency", "text": "Expected base salary", "required": true},
+		{"id": "f2a3b4c5-d6e7-4f8a-9b0c-1d2e3f4a5b6c", "type": "yes-no", "text": "Requires visa sponsorship", "required": false},
+		{"id": "a3b4c5d6-e7f8-4a9b-0c1d-2e3f4a5b6c7d", "type": "dropdown", "text": "Current visa", "options": []map[string]any{
+			{"text": "H-1B"}, {"text": "L-1"}, {"text": "Other"},
+		}},
+	},
+}
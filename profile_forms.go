@@ -0,0 +1,326 @@
+package lever
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever profile forms client interface
+type ProfileFormsClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single profile form
+	//
+	// This method returns the full profile form record for a single form on an opportunity.
+	GetProfileForm(ctx context.Context, req *GetProfileFormRequest) (*GetProfileFormResponse, error)
+
+	// List all profile forms
+	//
+	// Lists all profile forms for an opportunity.
+	ListProfileForms(ctx context.Context, req *ListProfileFormsRequest) (*ListProfileFormsResponse, error)
+
+	// Create a profile form
+	//
+	// Creates a profile form on an opportunity from a profile form template.
+	CreateProfileForm(ctx context.Context, req *CreateProfileFormRequest) (*CreateProfileFormResponse, error)
+
+	// Delete a profile form
+	//
+	// Deletes a profile form from an opportunity.
+	DeleteProfileForm(ctx context.Context, req *DeleteProfileFormRequest) (*DeleteProfileFormResponse, error)
+}
+
+// Parameters for retrieving a single profile form.
+type GetProfileFormRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The profile form id. This is required.
+	ProfileFormID string
+}
+
+// Create a new GetProfileFormRequest with the required fields.
+func NewGetProfileFormRequest(opportunityID, profileFormID string) *GetProfileFormRequest {
+	return &GetProfileFormRequest{
+		OpportunityID: opportunityID,
+		ProfileFormID: profileFormID,
+	}
+}
+
+func (r *GetProfileFormRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/forms/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.ProfileFormID))
+}
+
+// Response for retrieving a single profile form; returned to client users.
+type GetProfileFormResponse struct {
+	BaseResponse
+
+	// The profile form record.
+	ProfileForm *model.ProfileForm `json:"data"`
+}
+
+// JSON response type for retrieving a single profile form, with some field types dynamically
+// determined.
+type getProfileFormResponseJSON struct {
+	BaseResponse
+
+	// The profile form record.
+	ProfileForm *multimodel.ProfileForm `json:"data"`
+}
+
+// Parameters for listing profile forms.
+type ListProfileFormsRequest struct {
+	BaseListRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+}
+
+// Create a new ListProfileFormsRequest with the required fields.
+func NewListProfileFormsRequest(opportunityID string) *ListProfileFormsRequest {
+	return &ListProfileFormsRequest{
+		OpportunityID: opportunityID,
+	}
+}
+
+func (r *ListProfileFormsRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/forms", url.PathEscape(r.OpportunityID))
+}
+
+// Response for listing profile forms; returned to client users.
+type ListProfileFormsResponse struct {
+	BaseListResponse
+
+	// The profile form records.
+	ProfileForms []model.ProfileForm `json:"data"`
+}
+
+// JSON response type for listing profile forms, with some field types dynamically determined.
+type listProfileFormsResponseJSON struct {
+	BaseListResponse
+
+	// The profile form records.
+	ProfileForms []multimodel.ProfileForm `json:"data"`
+}
+
+// JSON body for the profile form create request.
+type profileFormRequestBody struct {
+	BaseTemplateID string                 `json:"baseTemplateId"`
+	FieldValues    []model.FormFieldValue `json:"fieldValues,omitempty"`
+	CreatedAt      *int64                 `json:"createdAt,omitempty"`
+	CompletedAt    *int64                 `json:"completedAt,omitempty"`
+}
+
+// Parameters for creating a profile form.
+type CreateProfileFormRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// Perform this create on behalf of a specified user. The form will be attributed to this user.
+	// This is required.
+	PerformAsID string
+
+	// The profile form template id the form is based on. This is required.
+	BaseTemplateID string
+
+	// The values of the fields in the form. This is optional.
+	FieldValues []model.FormFieldValue
+
+	// Datetime when the form was created. Defaults to the current time.
+	CreatedAt *int64
+
+	// Datetime when the form was completed. Defaults to the current time.
+	CompletedAt *int64
+}
+
+// Create a new CreateProfileFormRequest with the required fields.
+func NewCreateProfileFormRequest(performAsID, opportunityID, baseTemplateID string) *CreateProfileFormRequest {
+	return &CreateProfileFormRequest{
+		OpportunityID:  opportunityID,
+		PerformAsID:    performAsID,
+		BaseTemplateID: baseTemplateID,
+	}
+}
+
+func (r *CreateProfileFormRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/forms", url.PathEscape(r.OpportunityID))
+}
+
+func (r *CreateProfileFormRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreateProfileFormRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+func (r *CreateProfileFormRequest) GetBody() (io.Reader, error) {
+	body := profileFormRequestBody{
+		BaseTemplateID: r.BaseTemplateID,
+		FieldValues:    r.FieldValues,
+		CreatedAt:      r.CreatedAt,
+		CompletedAt:    r.CompletedAt,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Response for creating a profile form; returned to client users.
+type CreateProfileFormResponse struct {
+	BaseResponse
+
+	// The profile form record.
+	ProfileForm *model.ProfileForm `json:"data"`
+}
+
+// JSON response type for creating a profile form, with some field types dynamically determined.
+type createProfileFormResponseJSON struct {
+	BaseResponse
+
+	// The profile form record.
+	ProfileForm *multimodel.ProfileForm `json:"data"`
+}
+
+// Parameters for deleting a profile form.
+type DeleteProfileFormRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The profile form id. This is required.
+	ProfileFormID string
+
+	// Perform this delete on behalf of a specified user. This is required.
+	PerformAsID string
+}
+
+// Create a new DeleteProfileFormRequest with the required fields.
+func NewDeleteProfileFormRequest(performAsID, opportunityID, profileFormID string) *DeleteProfileFormRequest {
+	return &DeleteProfileFormRequest{
+		OpportunityID: opportunityID,
+		ProfileFormID: profileFormID,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *DeleteProfileFormRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/forms/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.ProfileFormID))
+}
+
+func (r *DeleteProfileFormRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+func (r *DeleteProfileFormRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+// Response for deleting a profile form.
+type DeleteProfileFormResponse struct {
+	BaseResponse
+}
+
+// Retrieve a single profile form
+//
+// This method returns the full profile form record for a single form on an opportunity.
+func (c *Client) GetProfileForm(ctx context.Context, req *GetProfileFormRequest) (*GetProfileFormResponse, error) {
+	var respJSON getProfileFormResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var profileForm model.ProfileForm
+	err := respJSON.ProfileForm.ToModel(&profileForm)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetProfileFormResponse{
+		BaseResponse: respJSON.BaseResponse,
+		ProfileForm:  &profileForm,
+	}
+
+	return &resp, nil
+}
+
+// List all profile forms
+//
+// Lists all profile forms for an opportunity.
+func (c *Client) ListProfileForms(ctx context.Context, req *ListProfileFormsRequest) (*ListProfileFormsResponse, error) {
+	var respJSON listProfileFormsResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	profileForms := make([]model.ProfileForm, len(respJSON.ProfileForms))
+	for i := range respJSON.ProfileForms {
+		err := respJSON.ProfileForms[i].ToModel(&profileForms[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListProfileFormsResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		ProfileForms:     profileForms,
+	}
+
+	return &resp, nil
+}
+
+// Create a profile form
+//
+// Creates a profile form on an opportunity from a profile form template.
+func (c *Client) CreateProfileForm(ctx context.Context, req *CreateProfileFormRequest) (*CreateProfileFormResponse, error) {
+	var respJSON createProfileFormResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var profileForm model.ProfileForm
+	err := respJSON.ProfileForm.ToModel(&profileForm)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := CreateProfileFormResponse{
+		BaseResponse: respJSON.BaseResponse,
+		ProfileForm:  &profileForm,
+	}
+
+	return &resp, nil
+}
+
+// Delete a profile form
+//
+// Deletes a profile form from an opportunity.
+func (c *Client) DeleteProfileForm(ctx context.Context, req *DeleteProfileFormRequest) (*DeleteProfileFormResponse, error) {
+	var resp DeleteProfileFormResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,169 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileForms(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{profileFormCompensation}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/forms"),
+			testclient.ExpectNoQuery(),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": expandProfileForm(profileFormCompensation, "user")}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/forms/9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b"),
+			testclient.ExpectQuery("expand", "user"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List profile forms
+	listResp, err := c.ListProfileForms(ctx, NewListProfileFormsRequest("250d8f03-738a-4bba-a671-8a3d73477145"))
+
+	if ta.NoError(err) && ta.Len(listResp.ProfileForms, 1) {
+		form := listResp.ProfileForms[0]
+		ta.Equal("9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b", form.ID)
+		ta.Equal("form", form.Type)
+		ta.Equal("2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c", form.BaseTemplateID)
+		ta.Equal("022d6639-1333-419b-9635-31f93015335f", form.UserID)
+		ta.Nil(form.User)
+		ta.Equal("00922a60-7c15-422b-b086-f62000824fd7", form.StageID)
+		ta.Nil(form.DeletedAt)
+
+		if field := form.Field("Expected base salary"); ta.NotNil(field) {
+			ta.Equal("e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b", field.ID)
+			ta.Equal(model.FormFieldTypeText, field.Type)
+			ta.Equal(model.FormFieldAnswer{Value: "135000"}, field.Answer())
+		}
+		if field := form.Field("Requires visa sponsorship"); ta.NotNil(field) {
+			ta.Equal(model.FormFieldTypeYesNo, field.Type)
+			ta.Equal("no", field.Value)
+		}
+	}
+
+	// Get a profile form with the user expanded
+	getReq := NewGetProfileFormRequest("250d8f03-738a-4bba-a671-8a3d73477145", "9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b")
+	getReq.Expand = []string{"user"}
+	getResp, err := c.GetProfileForm(ctx, getReq)
+
+	if ta.NoError(err) && ta.NotNil(getResp.ProfileForm.User) {
+		ta.Equal("Monica Geller", getResp.ProfileForm.User.Name)
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestCreateDeleteProfileForm(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": profileFormCompensation}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/forms"),
+			testclient.ExpectQuery("perform_as", "022d6639-1333-419b-9635-31f93015335f"),
+			testclient.ExpectBody(`{"baseTemplateId":"2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c","fieldValues":[{"id":"e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b","value":"135000"},{"id":"f2a3b4c5-d6e7-4f8a-9b0c-1d2e3f4a5b6c","value":"no"}]}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/forms/9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b"),
+			testclient.ExpectQuery("perform_as", "022d6639-1333-419b-9635-31f93015335f"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Create a profile form
+	createReq := NewCreateProfileFormRequest("022d6639-1333-419b-9635-31f93015335f", "250d8f03-738a-4bba-a671-8a3d73477145", "2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c")
+	createReq.FieldValues = []model.FormFieldValue{
+		{ID: "e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b", Value: "135000"},
+		{ID: "f2a3b4c5-d6e7-4f8a-9b0c-1d2e3f4a5b6c", Value: "no"},
+	}
+	createResp, err := c.CreateProfileForm(ctx, createReq)
+
+	if ta.NoError(err) {
+		ta.Equal("9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b", createResp.ProfileForm.ID)
+	}
+
+	// Delete a profile form
+	deleteReq := NewDeleteProfileFormRequest("022d6639-1333-419b-9635-31f93015335f", "250d8f03-738a-4bba-a671-8a3d73477145", "9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b")
+	_, err = c.DeleteProfileForm(ctx, deleteReq)
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+// expandProfileForm expands the specified fields in the profile form data.
+func expandProfileForm(orig map[string]any, fields ...string) map[string]any {
+	expanded := make(map[string]any)
+	for k, v := range orig {
+		expanded[k] = v
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "user":
+			expanded["user"] = expandUser(expanded["user"].(string))
+		case "stage":
+			expanded["stage"] = expandStage(expanded["stage"].(string))
+		}
+	}
+
+	return expanded
+}
+
+var profileFormCompensation = map[string]any{
+	"id":             "9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b",
+	"type":           "form",
+	"text":           "Compensation expectations",
+	"instructions":   "Record the candidate's expectations after the recruiter screen.",
+	"baseTemplateId": "2f1e0d9c-8b7a-4f6e-5d4c-3b2a1f0e9d8c",
+	"user":           "022d6639-1333-419b-9635-31f93015335f",
+	"stage":          "00922a60-7c15-422b-b086-f62000824fd7",
+	"createdAt":      1417588008434,
+	"completedAt":    1417588008434,
+	"deletedAt":      nil,
+	"fields": []map[string]any{
+		{
+			"id":       "e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b",
+			"type":     "text",
+			"text":     "Expected base salary",
+			"required": true,
+			"value":    "135000",
+		},
+		{
+			"id":    "f2a3b4c5-d6e7-4f8a-9b0c-1d2e3f4a5b6c",
+			"type":  "yes-no",
+			"text":  "Requires visa sponsorship",
+			"value": "no",
+		},
+	},
+}
@@ -0,0 +1,150 @@
+package lever
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever referrals client interface
+type ReferralsClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single referral
+	//
+	// This method returns the full referral record for a single referral on an opportunity.
+	GetReferral(ctx context.Context, req *GetReferralRequest) (*GetReferralResponse, error)
+
+	// List all referrals
+	//
+	// Lists all referrals for an opportunity.
+	ListReferrals(ctx context.Context, req *ListReferralsRequest) (*ListReferralsResponse, error)
+}
+
+// Parameters for retrieving a single referral.
+type GetReferralRequest struct {
+	BaseRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+
+	// The referral id. This is required.
+	ReferralID string
+}
+
+// Create a new GetReferralRequest with the required fields.
+func NewGetReferralRequest(opportunityID, referralID string) *GetReferralRequest {
+	return &GetReferralRequest{
+		OpportunityID: opportunityID,
+		ReferralID:    referralID,
+	}
+}
+
+func (r *GetReferralRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/referrals/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.ReferralID))
+}
+
+// Response for retrieving a single referral; returned to client users.
+type GetReferralResponse struct {
+	BaseResponse
+
+	// The referral record.
+	Referral *model.Referral `json:"data"`
+}
+
+// JSON response type for retrieving a single referral, with some field types dynamically
+// determined.
+type getReferralResponseJSON struct {
+	BaseResponse
+
+	// The referral record.
+	Referral *multimodel.Referral `json:"data"`
+}
+
+// Parameters for listing referrals.
+type ListReferralsRequest struct {
+	BaseListRequest
+
+	// The opportunity id. This is required.
+	OpportunityID string
+}
+
+// Create a new ListReferralsRequest with the required fields.
+func NewListReferralsRequest(opportunityID string) *ListReferralsRequest {
+	return &ListReferralsRequest{
+		OpportunityID: opportunityID,
+	}
+}
+
+func (r *ListReferralsRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/referrals", url.PathEscape(r.OpportunityID))
+}
+
+// Response for listing referrals; returned to client users.
+type ListReferralsResponse struct {
+	BaseListResponse
+
+	// The referral records.
+	Referrals []model.Referral `json:"data"`
+}
+
+// JSON response type for listing referrals, with some field types dynamically determined.
+type listReferralsResponseJSON struct {
+	BaseListResponse
+
+	// The referral records.
+	Referrals []multimodel.Referral `json:"data"`
+}
+
+// Retrieve a single referral
+//
+// This method returns the full referral record for a single referral on an opportunity.
+func (c *Client) GetReferral(ctx context.Context, req *GetReferralRequest) (*GetReferralResponse, error) {
+	var respJSON getReferralResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var referral model.Referral
+	err := respJSON.Referral.ToModel(&referral)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetReferralResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Referral:     &referral,
+	}
+
+	return &resp, nil
+}
+
+// List all referrals
+//
+// Lists all referrals for an opportunity.
+func (c *Client) ListReferrals(ctx context.Context, req *ListReferralsRequest) (*ListReferralsResponse, error) {
+	var respJSON listReferralsResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	referrals := make([]model.Referral, len(respJSON.Referrals))
+	for i := range respJSON.Referrals {
+		err := respJSON.Referrals[i].ToModel(&referrals[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListReferralsResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		Referrals:        referrals,
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,151 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReferrals(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{referralFormerColleague}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/referrals"),
+			testclient.ExpectNoQuery(),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": expandReferral(referralFormerColleague, "referrer", "stage")}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/referrals/5c3e6f28-9d1a-4b7e-8f2c-3a4b5c6d7e8f"),
+			testclient.ExpectQuery("expand", "referrer"),
+			testclient.ExpectQuery("expand", "stage"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List referrals
+	listResp, err := c.ListReferrals(ctx, NewListReferralsRequest("250d8f03-738a-4bba-a671-8a3d73477145"))
+
+	if ta.NoError(err) && ta.Len(listResp.Referrals, 1) {
+		referral := listResp.Referrals[0]
+		ta.Equal("5c3e6f28-9d1a-4b7e-8f2c-3a4b5c6d7e8f", referral.ID)
+		ta.Equal("referral", referral.Type)
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", referral.ReferrerID)
+		ta.Nil(referral.Referrer)
+		ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", referral.UserID)
+		ta.Equal("00922a60-7c15-422b-b086-f62000824fd7", referral.StageID)
+		if ta.NotNil(referral.CompletedAt) {
+			ta.Equal(int64(1417588008434), *referral.CompletedAt)
+		}
+
+		if field := referral.Field("Relationship"); ta.NotNil(field) {
+			ta.Equal(model.FormFieldTypeDropdown, field.Type)
+			ta.True(field.Required)
+			ta.True(field.IsOption("Former colleague"))
+			ta.Equal(model.FormFieldAnswer{Value: "Former colleague"}, field.Answer())
+		}
+		if field := referral.Field("Strengths"); ta.NotNil(field) {
+			ta.Equal(model.FormFieldAnswer{Values: []string{"Communication", "Leadership"}}, field.Answer())
+		}
+		if field := referral.Field("Years known"); ta.NotNil(field) {
+			ta.Equal(model.FormFieldAnswer{Value: "4"}, field.Answer())
+		}
+		if field := referral.Field("Anything else?"); ta.NotNil(field) {
+			ta.Nil(field.Value)
+			answer := field.Answer()
+			ta.True(answer.IsEmpty())
+		}
+		ta.Nil(referral.Field("Salary expectations"))
+	}
+
+	// Get a referral with the referrer and stage expanded
+	getReq := NewGetReferralRequest("250d8f03-738a-4bba-a671-8a3d73477145", "5c3e6f28-9d1a-4b7e-8f2c-3a4b5c6d7e8f")
+	getReq.Expand = []string{"referrer", "stage"}
+	getResp, err := c.GetReferral(ctx, getReq)
+
+	if ta.NoError(err) {
+		referral := getResp.Referral
+		if ta.NotNil(referral.Referrer) {
+			ta.Equal("Rachel Green", referral.Referrer.Name)
+		}
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", referral.ReferrerID)
+		ta.Nil(referral.User)
+		if ta.NotNil(referral.Stage) {
+			ta.Equal("00922a60-7c15-422b-b086-f62000824fd7", referral.Stage.ID)
+		}
+	}
+
+	ta.Empty(s.Expected)
+}
+
+// expandReferral expands the specified fields in the referral data.
+func expandReferral(orig map[string]any, fields ...string) map[string]any {
+	expanded := make(map[string]any)
+	for k, v := range orig {
+		expanded[k] = v
+	}
+
+	for _, field := range fields {
+		switch field {
+		case "referrer", "user":
+			expanded[field] = expandUser(expanded[field].(string))
+		case "stage":
+			expanded["stage"] = expandStage(expanded["stage"].(string))
+		}
+	}
+
+	return expanded
+}
+
+var referralFormerColleague = map[string]any{
+	"id":             "5c3e6f28-9d1a-4b7e-8f2c-3a4b5c6d7e8f",
+	"type":           "referral",
+	"text":           "Referral",
+	"instructions":   "Tell us about the candidate you are referring.",
+	"baseTemplateId": "8b5c4e3d-2a1f-4e9d-8c7b-6a5f4e3d2c1b",
+	"referrer":       "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+	"user":           "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"stage":          "00922a60-7c15-422b-b086-f62000824fd7",
+	"createdAt":      1417588008434,
+	"completedAt":    1417588008434,
+	"fields": []map[string]any{
+		{
+			"type":     "dropdown",
+			"text":     "Relationship",
+			"required": true,
+			"options":  []map[string]any{{"text": "Former colleague"}, {"text": "Friend"}, {"text": "Other"}},
+			"value":    "Former colleague",
+		},
+		{
+			"type":    "multiple-select",
+			"text":    "Strengths",
+			"options": []map[string]any{{"text": "Communication"}, {"text": "Leadership"}, {"text": "Technical"}},
+			"value":   []string{"Communication", "Leadership"},
+		},
+		{
+			"type":  "text",
+			"text":  "Years known",
+			"value": 4,
+		},
+		{
+			"type":  "textarea",
+			"text":  "Anything else?",
+			"value": nil,
+		},
+	},
+}
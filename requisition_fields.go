@@ -0,0 +1,574 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever requisition fields client interface
+type RequisitionFieldsClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single requisition field
+	GetRequisitionField(ctx context.Context, req *GetRequisitionFieldRequest) (*GetRequisitionFieldResponse, error)
+
+	// List all requisition fields
+	//
+	// Lists all requisition fields in your Lever account.
+	ListRequisitionFields(ctx context.Context, req *ListRequisitionFieldsRequest) (*ListRequisitionFieldsResponse, error)
+
+	// Create a requisition field
+	CreateRequisitionField(ctx context.Context, req *CreateRequisitionFieldRequest) (*CreateRequisitionFieldResponse, error)
+
+	// Update a requisition field
+	//
+	// This replaces the requisition field; all fields must be specified. The field type cannot be
+	// changed.
+	UpdateRequisitionField(ctx context.Context, req *UpdateRequisitionFieldRequest) (*UpdateRequisitionFieldResponse, error)
+
+	// Delete a requisition field
+	DeleteRequisitionField(ctx context.Context, req *DeleteRequisitionFieldRequest) (*DeleteRequisitionFieldResponse, error)
+
+	// Add options to a dropdown requisition field
+	AddRequisitionFieldOptions(ctx context.Context, req *AddRequisitionFieldOptionsRequest) (*AddRequisitionFieldOptionsResponse, error)
+
+	// Update options on a dropdown requisition field
+	//
+	// Options are matched by id; only their text can be changed.
+	UpdateRequisitionFieldOptions(ctx context.Context, req *UpdateRequisitionFieldOptionsRequest) (*UpdateRequisitionFieldOptionsResponse, error)
+
+	// Remove options from a dropdown requisition field
+	RemoveRequisitionFieldOptions(ctx context.Context, req *RemoveRequisitionFieldOptionsRequest) (*RemoveRequisitionFieldOptionsResponse, error)
+}
+
+// Parameters for retrieving a single requisition field.
+type GetRequisitionFieldRequest struct {
+	BaseRequest
+
+	// The requisition field id. This is required.
+	RequisitionFieldID string
+}
+
+// Create a new GetRequisitionFieldRequest with the required fields.
+func NewGetRequisitionFieldRequest(requisitionFieldID string) *GetRequisitionFieldRequest {
+	return &GetRequisitionFieldRequest{
+		RequisitionFieldID: requisitionFieldID,
+	}
+}
+
+func (r *GetRequisitionFieldRequest) GetPath() string {
+	return fmt.Sprintf("requisition_fields/%s", url.PathEscape(r.RequisitionFieldID))
+}
+
+// Response for retrieving a single requisition field.
+type GetRequisitionFieldResponse struct {
+	BaseResponse
+
+	// The requisition field record.
+	RequisitionField *model.RequisitionField `json:"data"`
+}
+
+// Parameters for listing requisition fields.
+type ListRequisitionFieldsRequest struct {
+	BaseListRequest
+}
+
+// Create a new ListRequisitionFieldsRequest with the required fields.
+func NewListRequisitionFieldsRequest() *ListRequisitionFieldsRequest {
+	return &ListRequisitionFieldsRequest{}
+}
+
+func (r *ListRequisitionFieldsRequest) GetPath() string {
+	return "requisition_fields"
+}
+
+// Response for listing requisition fields.
+type ListRequisitionFieldsResponse struct {
+	BaseListResponse
+
+	// The requisition field records.
+	RequisitionFields []model.RequisitionField `json:"data"`
+}
+
+// Fields common to requisition field create and update requests.
+type RequisitionFieldFields struct {
+	// Field name. This is required.
+	Text string
+
+	// Field type. One of the model.RequisitionFieldType constants. This is required.
+	Type string
+
+	// Whether a value for this field is required on every requisition.
+	IsRequired bool
+
+	// The options for dropdown fields. At least one option is required for dropdown fields.
+	Options []model.RequisitionFieldOption
+
+	// The subfields of object fields. At least one subfield is required for object fields, and
+	// subfields cannot themselves be object fields.
+	Subfields []model.RequisitionField
+}
+
+// Check that the required requisition field fields are present. All problems found are returned,
+// joined with [errors.Join].
+func (f *RequisitionFieldFields) Validate() error {
+	var errs []error
+
+	if f.Text == "" {
+		errs = append(errs, errors.New("text is required"))
+	}
+
+	switch f.Type {
+	case "":
+		errs = append(errs, errors.New("type is required"))
+
+	case model.RequisitionFieldTypeDropdown:
+		if len(f.Options) == 0 {
+			errs = append(errs, errors.New("dropdown field requires options"))
+		}
+
+	case model.RequisitionFieldTypeObject:
+		if len(f.Subfields) == 0 {
+			errs = append(errs, errors.New("object field requires subfields"))
+		}
+	}
+
+	for i, subfield := range f.Subfields {
+		if subfield.ID == "" {
+			errs = append(errs, fmt.Errorf("subfield %d: id is required", i))
+		}
+
+		if subfield.Text == "" {
+			errs = append(errs, fmt.Errorf("subfield %d: text is required", i))
+		}
+
+		switch subfield.Type {
+		case "":
+			errs = append(errs, fmt.Errorf("subfield %d: type is required", i))
+
+		case model.RequisitionFieldTypeDropdown:
+			if len(subfield.Options) == 0 {
+				errs = append(errs, fmt.Errorf("subfield %d: dropdown field requires options", i))
+			}
+
+		case model.RequisitionFieldTypeObject:
+			errs = append(errs, fmt.Errorf("subfield %d: subfields cannot be object fields", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// JSON body for the requisition field create and update requests.
+type requisitionFieldRequestBody struct {
+	ID         string                         `json:"id,omitempty"`
+	Text       string                         `json:"text"`
+	Type       string                         `json:"type"`
+	IsRequired bool                           `json:"isRequired"`
+	Options    []model.RequisitionFieldOption `json:"options,omitempty"`
+	Subfields  []model.RequisitionField       `json:"subfields,omitempty"`
+}
+
+// Encode the requisition field fields as a JSON request body. The id is only sent when creating
+// a field.
+func (f *RequisitionFieldFields) encode(id string) (io.Reader, error) {
+	body := requisitionFieldRequestBody{
+		ID:         id,
+		Text:       f.Text,
+		Type:       f.Type,
+		IsRequired: f.IsRequired,
+		Options:    f.Options,
+		Subfields:  f.Subfields,
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Parameters for creating a requisition field.
+type CreateRequisitionFieldRequest struct {
+	BaseRequest
+	RequisitionFieldFields
+
+	// The field key, used as the key in requisition custom fields. This is required.
+	RequisitionFieldID string
+}
+
+// Create a new CreateRequisitionFieldRequest with the required fields.
+func NewCreateRequisitionFieldRequest(requisitionFieldID, text, fieldType string) *CreateRequisitionFieldRequest {
+	return &CreateRequisitionFieldRequest{
+		RequisitionFieldFields: RequisitionFieldFields{
+			Text: text,
+			Type: fieldType,
+		},
+		RequisitionFieldID: requisitionFieldID,
+	}
+}
+
+// Check that the required requisition field fields are present. All problems found are returned,
+// joined with [errors.Join].
+func (r *CreateRequisitionFieldRequest) Validate() error {
+	var errs []error
+
+	if r.RequisitionFieldID == "" {
+		errs = append(errs, errors.New("requisition field id is required"))
+	}
+
+	errs = append(errs, r.RequisitionFieldFields.Validate())
+
+	return errors.Join(errs...)
+}
+
+func (r *CreateRequisitionFieldRequest) GetPath() string {
+	return "requisition_fields"
+}
+
+func (r *CreateRequisitionFieldRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreateRequisitionFieldRequest) GetBody() (io.Reader, error) {
+	return r.RequisitionFieldFields.encode(r.RequisitionFieldID)
+}
+
+// Response for creating a requisition field.
+type CreateRequisitionFieldResponse struct {
+	BaseResponse
+
+	// The requisition field record.
+	RequisitionField *model.RequisitionField `json:"data"`
+}
+
+// Parameters for updating a requisition field.
+type UpdateRequisitionFieldRequest struct {
+	BaseRequest
+	RequisitionFieldFields
+
+	// The requisition field id. This is required.
+	RequisitionFieldID string
+}
+
+// Create a new UpdateRequisitionFieldRequest with the required fields.
+func NewUpdateRequisitionFieldRequest(requisitionFieldID, text, fieldType string) *UpdateRequisitionFieldRequest {
+	return &UpdateRequisitionFieldRequest{
+		RequisitionFieldFields: RequisitionFieldFields{
+			Text: text,
+			Type: fieldType,
+		},
+		RequisitionFieldID: requisitionFieldID,
+	}
+}
+
+// Create a new UpdateRequisitionFieldRequest based on an existing RequisitionField struct.
+func NewUpdateRequisitionFieldRequestFromRequisitionField(field *model.RequisitionField) *UpdateRequisitionFieldRequest {
+	req := NewUpdateRequisitionFieldRequest(field.ID, field.Text, field.Type)
+	req.IsRequired = field.IsRequired
+	req.Options = field.Options
+	req.Subfields = field.Subfields
+
+	return req
+}
+
+func (r *UpdateRequisitionFieldRequest) GetPath() string {
+	return fmt.Sprintf("requisition_fields/%s", url.PathEscape(r.RequisitionFieldID))
+}
+
+func (r *UpdateRequisitionFieldRequest) GetHTTPMethod() string {
+	return http.MethodPut
+}
+
+func (r *UpdateRequisitionFieldRequest) GetBody() (io.Reader, error) {
+	return r.RequisitionFieldFields.encode("")
+}
+
+// Response for updating a requisition field.
+type UpdateRequisitionFieldResponse struct {
+	BaseResponse
+
+	// The requisition field record.
+	RequisitionField *model.RequisitionField `json:"data"`
+}
+
+// Parameters for deleting a requisition field.
+type DeleteRequisitionFieldRequest struct {
+	BaseRequest
+
+	// The requisition field id. This is required.
+	RequisitionFieldID string
+}
+
+// Create a new DeleteRequisitionFieldRequest with the required fields.
+func NewDeleteRequisitionFieldRequest(requisitionFieldID string) *DeleteRequisitionFieldRequest {
+	return &DeleteRequisitionFieldRequest{
+		RequisitionFieldID: requisitionFieldID,
+	}
+}
+
+func (r *DeleteRequisitionFieldRequest) GetPath() string {
+	return fmt.Sprintf("requisition_fields/%s", url.PathEscape(r.RequisitionFieldID))
+}
+
+func (r *DeleteRequisitionFieldRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+// Response for deleting a requisition field.
+type DeleteRequisitionFieldResponse struct {
+	BaseResponse
+}
+
+// JSON body for the requisition field option requests.
+type requisitionFieldOptionsRequestBody struct {
+	Values []model.RequisitionFieldOption `json:"values"`
+}
+
+// Parameters for adding options to a dropdown requisition field.
+type AddRequisitionFieldOptionsRequest struct {
+	BaseRequest
+
+	// The requisition field id. This is required.
+	RequisitionFieldID string
+
+	// The text of the options to add. At least one is required.
+	Options []string
+}
+
+// Create a new AddRequisitionFieldOptionsRequest with the required fields.
+func NewAddRequisitionFieldOptionsRequest(requisitionFieldID string, options ...string) *AddRequisitionFieldOptionsRequest {
+	return &AddRequisitionFieldOptionsRequest{
+		RequisitionFieldID: requisitionFieldID,
+		Options:            options,
+	}
+}
+
+func (r *AddRequisitionFieldOptionsRequest) GetPath() string {
+	return fmt.Sprintf("requisition_fields/%s/options", url.PathEscape(r.RequisitionFieldID))
+}
+
+func (r *AddRequisitionFieldOptionsRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *AddRequisitionFieldOptionsRequest) GetBody() (io.Reader, error) {
+	body := requisitionFieldOptionsRequestBody{
+		Values: make([]model.RequisitionFieldOption, len(r.Options)),
+	}
+
+	for i, text := range r.Options {
+		body.Values[i].Text = text
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Response for adding options to a dropdown requisition field.
+type AddRequisitionFieldOptionsResponse struct {
+	BaseResponse
+
+	// The updated requisition field record.
+	RequisitionField *model.RequisitionField `json:"data"`
+}
+
+// Parameters for updating options on a dropdown requisition field.
+type UpdateRequisitionFieldOptionsRequest struct {
+	BaseRequest
+
+	// The requisition field id. This is required.
+	RequisitionFieldID string
+
+	// The options to update, identified by id, with their new text. At least one is required.
+	Options []model.RequisitionFieldOption
+}
+
+// Create a new UpdateRequisitionFieldOptionsRequest with the required fields.
+func NewUpdateRequisitionFieldOptionsRequest(requisitionFieldID string, options ...model.RequisitionFieldOption) *UpdateRequisitionFieldOptionsRequest {
+	return &UpdateRequisitionFieldOptionsRequest{
+		RequisitionFieldID: requisitionFieldID,
+		Options:            options,
+	}
+}
+
+func (r *UpdateRequisitionFieldOptionsRequest) GetPath() string {
+	return fmt.Sprintf("requisition_fields/%s/options", url.PathEscape(r.RequisitionFieldID))
+}
+
+func (r *UpdateRequisitionFieldOptionsRequest) GetHTTPMethod() string {
+	return http.MethodPut
+}
+
+func (r *UpdateRequisitionFieldOptionsRequest) GetBody() (io.Reader, error) {
+	return encodeJSONBody(requisitionFieldOptionsRequestBody{Values: r.Options})
+}
+
+// Response for updating options on a dropdown requisition field.
+type UpdateRequisitionFieldOptionsResponse struct {
+	BaseResponse
+
+	// The updated requisition field record.
+	RequisitionField *model.RequisitionField `json:"data"`
+}
+
+// Parameters for removing options from a dropdown requisition field.
+type RemoveRequisitionFieldOptionsRequest struct {
+	BaseRequest
+
+	// The requisition field id. This is required.
+	RequisitionFieldID string
+
+	// The ids of the options to remove. At least one is required.
+	OptionIDs []string
+}
+
+// Create a new RemoveRequisitionFieldOptionsRequest with the required fields.
+func NewRemoveRequisitionFieldOptionsRequest(requisitionFieldID string, optionIDs ...string) *RemoveRequisitionFieldOptionsRequest {
+	return &RemoveRequisitionFieldOptionsRequest{
+		RequisitionFieldID: requisitionFieldID,
+		OptionIDs:          optionIDs,
+	}
+}
+
+func (r *RemoveRequisitionFieldOptionsRequest) GetPath() string {
+	return fmt.Sprintf("requisition_fields/%s/options", url.PathEscape(r.RequisitionFieldID))
+}
+
+func (r *RemoveRequisitionFieldOptionsRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+func (r *RemoveRequisitionFieldOptionsRequest) GetBody() (io.Reader, error) {
+	body := requisitionFieldOptionsRequestBody{
+		Values: make([]model.RequisitionFieldOption, len(r.OptionIDs)),
+	}
+
+	for i, id := range r.OptionIDs {
+		body.Values[i].ID = id
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Response for removing options from a dropdown requisition field.
+type RemoveRequisitionFieldOptionsResponse struct {
+	BaseResponse
+
+	// The updated requisition field record.
+	RequisitionField *model.RequisitionField `json:"data"`
+}
+
+// Retrieve a single requisition field
+func (c *Client) GetRequisitionField(ctx context.Context, req *GetRequisitionFieldRequest) (*GetRequisitionFieldResponse, error) {
+	var resp GetRequisitionFieldResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// List all requisition fields
+//
+// Lists all requisition fields in your Lever account.
+func (c *Client) ListRequisitionFields(ctx context.Context, req *ListRequisitionFieldsRequest) (*ListRequisitionFieldsResponse, error) {
+	var resp ListRequisitionFieldsResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Create a requisition field
+func (c *Client) CreateRequisitionField(ctx context.Context, req *CreateRequisitionFieldRequest) (*CreateRequisitionFieldResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp CreateRequisitionFieldResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update a requisition field
+//
+// This replaces the requisition field; all fields must be specified. The field type cannot be
+// changed.
+func (c *Client) UpdateRequisitionField(ctx context.Context, req *UpdateRequisitionFieldRequest) (*UpdateRequisitionFieldResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp UpdateRequisitionFieldResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete a requisition field
+func (c *Client) DeleteRequisitionField(ctx context.Context, req *DeleteRequisitionFieldRequest) (*DeleteRequisitionFieldResponse, error) {
+	var resp DeleteRequisitionFieldResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Add options to a dropdown requisition field
+func (c *Client) AddRequisitionFieldOptions(ctx context.Context, req *AddRequisitionFieldOptionsRequest) (*AddRequisitionFieldOptionsResponse, error) {
+	if len(req.Options) == 0 {
+		return nil, errors.New("at least one option is required")
+	}
+
+	var resp AddRequisitionFieldOptionsResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update options on a dropdown requisition field
+//
+// Options are matched by id; only their text can be changed.
+func (c *Client) UpdateRequisitionFieldOptions(ctx context.Context, req *UpdateRequisitionFieldOptionsRequest) (*UpdateRequisitionFieldOptionsResponse, error) {
+	if len(req.Options) == 0 {
+		return nil, errors.New("at least one option is required")
+	}
+
+	for i, option := range req.Options {
+		if option.ID == "" {
+			return nil, fmt.Errorf("option %d: id is required", i)
+		}
+	}
+
+	var resp UpdateRequisitionFieldOptionsResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Remove options from a dropdown requisition field
+func (c *Client) RemoveRequisitionFieldOptions(ctx context.Context, req *RemoveRequisitionFieldOptionsRequest) (*RemoveRequisitionFieldOptionsResponse, error) {
+	if len(req.OptionIDs) == 0 {
+		return nil, errors.New("at least one option id is required")
+	}
+
+	var resp RemoveRequisitionFieldOptionsResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
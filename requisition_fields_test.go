@@ -0,0 +1,303 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequisitionFields(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{requisitionFieldWorkArrangement, requisitionFieldCompensation}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/requisition_fields"),
+			testclient.ExpectNoQuery(),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": requisitionFieldCompensation}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/requisition_fields/compensation"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List requisition fields
+	listResp, err := c.ListRequisitionFields(ctx, NewListRequisitionFieldsRequest())
+
+	if ta.NoError(err) && ta.Len(listResp.RequisitionFields, 2) {
+		field := listResp.RequisitionFields[0]
+		ta.Equal("work_arrangement", field.ID)
+		ta.Equal(model.RequisitionFieldTypeDropdown, field.Type)
+		ta.True(field.IsRequired)
+		if option := field.Option("Hybrid"); ta.NotNil(option) {
+			ta.Equal("b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", option.ID)
+		}
+		ta.Nil(field.Option("Office"))
+	}
+
+	// Get an object field with subfields
+	getResp, err := c.GetRequisitionField(ctx, NewGetRequisitionFieldRequest("compensation"))
+
+	if ta.NoError(err) {
+		field := getResp.RequisitionField
+		ta.Equal(model.RequisitionFieldTypeObject, field.Type)
+		if ta.Len(field.Subfields, 3) {
+			ta.Equal(model.RequisitionFieldTypeNumber, field.Subfields[0].Type)
+			ta.True(field.Subfields[0].IsRequired)
+		}
+		if subfield := field.Subfield("currency"); ta.NotNil(subfield) {
+			ta.Len(subfield.Options, 2)
+		}
+		ta.Nil(field.Subfield("bonus"))
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestCreateUpdateDeleteRequisitionField(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": requisitionFieldCompensation}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/requisition_fields"),
+			testclient.ExpectBody(`{"id":"compensation","text":"Compensation","type":"object","isRequired":false,"subfields":[{"id":"base","text":"Base salary","type":"number","isRequired":true},{"id":"currency","text":"Currency","type":"dropdown","options":[{"text":"USD"},{"text":"EUR"}]}]}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": requisitionFieldWorkArrangement}),
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath("/v1/requisition_fields/work_arrangement"),
+			testclient.ExpectBody(`{"text":"Work arrangement","type":"dropdown","isRequired":false,"options":[{"id":"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d","text":"Remote"},{"id":"b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e","text":"Hybrid"}]}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath("/v1/requisition_fields/compensation"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Invalid fields are rejected before sending
+	createReq := NewCreateRequisitionFieldRequest("compensation", "Compensation", model.RequisitionFieldTypeObject)
+	createReq.Subfields = []model.RequisitionField{
+		{ID: "base", Text: "Base salary", Type: model.RequisitionFieldTypeNumber, IsRequired: true},
+		{ID: "currency", Text: "Currency", Type: model.RequisitionFieldTypeDropdown},
+		{ID: "nested", Text: "Nested", Type: model.RequisitionFieldTypeObject},
+	}
+	_, err := c.CreateRequisitionField(ctx, createReq)
+
+	if ta.Error(err) {
+		ta.ErrorContains(err, "subfield 1: dropdown field requires options")
+		ta.ErrorContains(err, "subfield 2: subfields cannot be object fields")
+	}
+
+	_, err = c.CreateRequisitionField(ctx, NewCreateRequisitionFieldRequest("", "Cost center", model.RequisitionFieldTypeDropdown))
+
+	if ta.Error(err) {
+		ta.ErrorContains(err, "requisition field id is required")
+		ta.ErrorContains(err, "dropdown field requires options")
+	}
+
+	// Create an object field
+	createReq.Subfields = createReq.Subfields[:2]
+	createReq.Subfields[1].Options = []model.RequisitionFieldOption{{Text: "USD"}, {Text: "EUR"}}
+	createResp, err := c.CreateRequisitionField(ctx, createReq)
+
+	if ta.NoError(err) {
+		ta.Equal("compensation", createResp.RequisitionField.ID)
+	}
+
+	// Update a field from an existing record
+	field := model.RequisitionField{
+		ID:         "work_arrangement",
+		Text:       "Work arrangement",
+		Type:       model.RequisitionFieldTypeDropdown,
+		IsRequired: true,
+		Options: []model.RequisitionFieldOption{
+			{ID: "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", Text: "Remote"},
+			{ID: "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", Text: "Hybrid"},
+		},
+	}
+	updateReq := NewUpdateRequisitionFieldRequestFromRequisitionField(&field)
+	updateReq.IsRequired = false
+	updateResp, err := c.UpdateRequisitionField(ctx, updateReq)
+
+	if ta.NoError(err) {
+		ta.Equal("work_arrangement", updateResp.RequisitionField.ID)
+	}
+
+	// Delete a field
+	_, err = c.DeleteRequisitionField(ctx, NewDeleteRequisitionFieldRequest("compensation"))
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+func TestRequisitionFieldOptions(t *testing.T) {
+	ta := assert.New(t)
+
+	const optionsPath = "/v1/requisition_fields/work_arrangement/options"
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": requisitionFieldWorkArrangement}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath(optionsPath),
+			testclient.ExpectBody(`{"values":[{"text":"Office"},{"text":"Field & travel"}]}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": requisitionFieldWorkArrangement}),
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath(optionsPath),
+			testclient.ExpectBody(`{"values":[{"id":"b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e","text":"Hybrid (3 days)"}]}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": requisitionFieldWorkArrangement}),
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath(optionsPath),
+			testclient.ExpectBody(`{"values":[{"id":"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"}]}`+"\n"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Add options
+	addResp, err := c.AddRequisitionFieldOptions(ctx, NewAddRequisitionFieldOptionsRequest("work_arrangement", "Office", "Field & travel"))
+
+	if ta.NoError(err) {
+		ta.Equal("work_arrangement", addResp.RequisitionField.ID)
+	}
+
+	_, err = c.AddRequisitionFieldOptions(ctx, NewAddRequisitionFieldOptionsRequest("work_arrangement"))
+	ta.Error(err)
+
+	// Update options
+	_, err = c.UpdateRequisitionFieldOptions(ctx, NewUpdateRequisitionFieldOptionsRequest("work_arrangement", model.RequisitionFieldOption{Text: "Hybrid (3 days)"}))
+	ta.ErrorContains(err, "option 0: id is required")
+
+	_, err = c.UpdateRequisitionFieldOptions(ctx, NewUpdateRequisitionFieldOptionsRequest("work_arrangement",
+		model.RequisitionFieldOption{ID: "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", Text: "Hybrid (3 days)"}))
+	ta.NoError(err)
+
+	// Remove options
+	_, err = c.RemoveRequisitionFieldOptions(ctx, NewRemoveRequisitionFieldOptionsRequest("work_arrangement", "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"))
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+func TestValidateRequisitionCustomFields(t *testing.T) {
+	ta := assert.New(t)
+
+	definitions := []model.RequisitionField{
+		{
+			ID:         "work_arrangement",
+			Text:       "Work arrangement",
+			Type:       model.RequisitionFieldTypeDropdown,
+			IsRequired: true,
+			Options:    []model.RequisitionFieldOption{{Text: "Remote"}, {Text: "Hybrid"}},
+		},
+		{
+			ID:   "compensation",
+			Text: "Compensation",
+			Type: model.RequisitionFieldTypeObject,
+			Subfields: []model.RequisitionField{
+				{ID: "base", Text: "Base salary", Type: model.RequisitionFieldTypeNumber, IsRequired: true},
+				{ID: "currency", Text: "Currency", Type: model.RequisitionFieldTypeDropdown, Options: []model.RequisitionFieldOption{{Text: "USD"}}},
+			},
+		},
+		{ID: "target_start", Text: "Target start date", Type: model.RequisitionFieldTypeDate},
+		{ID: "cost_center", Text: "Cost center", Type: model.RequisitionFieldTypeText},
+	}
+
+	// Valid values
+	fields := RequisitionFields{
+		CustomFields: map[string]any{
+			"work_arrangement": "Remote",
+			"compensation":     map[string]any{"base": 150000, "currency": "USD"},
+			"target_start":     float64(1425168000000),
+			"cost_center":      "CC-100",
+		},
+	}
+	ta.NoError(fields.ValidateCustomFields(definitions))
+
+	// Invalid values
+	fields.CustomFields = map[string]any{
+		"compensation": map[string]any{"currency": "GBP", "bonus": 10},
+		"target_start": "2015-03-01",
+		"cost_center":  100,
+		"headcount":    3,
+	}
+	err := fields.ValidateCustomFields(definitions)
+
+	if ta.Error(err) {
+		ta.Equal(`custom field "compensation.bonus" is not defined
+custom field "compensation.currency": "GBP" is not a valid option
+custom field "compensation.base" is required
+custom field "cost_center" must be a string
+custom field "headcount" is not defined
+custom field "target_start" must be a number
+custom field "work_arrangement" is required`, err.Error())
+	}
+}
+
+var requisitionFieldWorkArrangement = map[string]any{
+	"id":         "work_arrangement",
+	"text":       "Work arrangement",
+	"type":       "dropdown",
+	"isRequired": true,
+	"createdAt":  1407460071043,
+	"options": []map[string]any{
+		{"id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", "text": "Remote"},
+		{"id": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", "text": "Hybrid"},
+	},
+}
+
+var requisitionFieldCompensation = map[string]any{
+	"id":         "compensation",
+	"text":       "Compensation",
+	"type":       "object",
+	"isRequired": false,
+	"createdAt":  1407460071043,
+	"subfields": []map[string]any{
+		{"id": "base", "text": "Base salary", "type": "number", "isRequired": true},
+		{"id": "currency", "text": "Currency", "type": "dropdown", "isRequired": false, "options": []map[string]any{
+			{"id": "c3d4e5f6-a7b8-4c9d-0e1f-2a3b4c5d6e7f", "text": "USD"},
+			{"id": "d4e5f6a7-b8c9-4d0e-1f2a-3b4c5d6e7f80", "text": "EUR"},
+		}},
+		{"id": "bonus_eligible", "text": "Bonus eligible", "type": "text", "isRequired": false},
+	},
+}
@@ -0,0 +1,617 @@
+package lever
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/multimodel"
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever requisitions client interface
+type RequisitionsClientInterface interface {
+	ClientInterface
+
+	// Retrieve a single requisition
+	GetRequisition(ctx context.Context, req *GetRequisitionRequest) (*GetRequisitionResponse, error)
+
+	// List all requisitions
+	//
+	// Lists all requisitions in your Lever account.
+	ListRequisitions(ctx context.Context, req *ListRequisitionsRequest) (*ListRequisitionsResponse, error)
+
+	// Create a requisition
+	CreateRequisition(ctx context.Context, req *CreateRequisitionRequest) (*CreateRequisitionResponse, error)
+
+	// Update a requisition
+	//
+	// This replaces the requisition; all fields must be specified.
+	UpdateRequisition(ctx context.Context, req *UpdateRequisitionRequest) (*UpdateRequisitionResponse, error)
+
+	// Delete a requisition
+	//
+	// Deletes a requisition. Only requisitions created via the API can be deleted.
+	DeleteRequisition(ctx context.Context, req *DeleteRequisitionRequest) (*DeleteRequisitionResponse, error)
+}
+
+// Parameters for retrieving a single requisition.
+type GetRequisitionRequest struct {
+	BaseRequest
+
+	// The requisition id. This is required.
+	RequisitionID string
+}
+
+// Create a new GetRequisitionRequest with the required fields.
+func NewGetRequisitionRequest(requisitionID string) *GetRequisitionRequest {
+	return &GetRequisitionRequest{
+		RequisitionID: requisitionID,
+	}
+}
+
+func (r *GetRequisitionRequest) GetPath() string {
+	return fmt.Sprintf("requisitions/%s", url.PathEscape(r.RequisitionID))
+}
+
+// Response for retrieving a single requisition; returned to client users.
+type GetRequisitionResponse struct {
+	BaseResponse
+
+	// The requisition record.
+	Requisition *model.Requisition `json:"data"`
+}
+
+// JSON response type for retrieving a single requisition, with some field types dynamically
+// determined.
+type getRequisitionResponseJSON struct {
+	BaseResponse
+
+	// The requisition record.
+	Requisition *multimodel.Requisition `json:"data"`
+}
+
+// Parameters for listing requisitions.
+type ListRequisitionsRequest struct {
+	BaseListRequest
+
+	// Filter requisitions by requisition code.
+	RequisitionCodes []string
+
+	// Filter requisitions by status (open, onHold, closed or draft).
+	Status string
+
+	// Filter requisitions by confidentiality (confidential, non-confidential or all). If
+	// unspecified, Lever returns non-confidential requisitions only.
+	Confidentiality string
+
+	// If set, filter requisitions by the timestamp they were created at. If only CreatedAtStart
+	// is specified, all requisitions created from that timestamp (inclusive) to the present will
+	// be included. If only CreatedAtEnd is specified, all requisitions created before that
+	// timestamp (inclusive) are included.
+	CreatedAtStart *int64
+	CreatedAtEnd   *int64
+}
+
+// Create a new ListRequisitionsRequest with the required fields.
+func NewListRequisitionsRequest() *ListRequisitionsRequest {
+	return &ListRequisitionsRequest{}
+}
+
+func (r *ListRequisitionsRequest) GetPath() string {
+	return "requisitions"
+}
+
+func (r *ListRequisitionsRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseListRequest.AddAPIQueryParams(query)
+
+	for _, requisitionCode := range r.RequisitionCodes {
+		query.Add(paramRequisitionCode, requisitionCode)
+	}
+
+	if r.Status != "" {
+		query.Add(paramStatus, r.Status)
+	}
+
+	if r.Confidentiality != "" {
+		query.Add(paramConfidentiality, r.Confidentiality)
+	}
+
+	if r.CreatedAtStart != nil {
+		query.Add(paramCreatedAtStart, fmt.Sprint(*r.CreatedAtStart))
+	}
+
+	if r.CreatedAtEnd != nil {
+		query.Add(paramCreatedAtEnd, fmt.Sprint(*r.CreatedAtEnd))
+	}
+}
+
+// Response for listing requisitions; returned to client users.
+type ListRequisitionsResponse struct {
+	BaseListResponse
+
+	// The requisition records.
+	Requisitions []model.Requisition `json:"data"`
+}
+
+// JSON response type for listing requisitions, with some field types dynamically determined.
+type listRequisitionsResponseJSON struct {
+	BaseListResponse
+
+	// The requisition records.
+	Requisitions []multimodel.Requisition `json:"data"`
+}
+
+// Fields common to requisition create and update requests.
+type RequisitionFields struct {
+	// The requisition code. This is required.
+	RequisitionCode string
+
+	// The human-readable name of the requisition. This is required.
+	Name string
+
+	// The total number of hires approved for the requisition. This must be positive unless
+	// HeadcountUnlimited is set.
+	HeadcountTotal int
+
+	// Whether an unlimited number of hires can be made against the requisition.
+	HeadcountUnlimited bool
+
+	// The requisition status (open, onHold, closed or draft).
+	Status string
+
+	// Whether the requisition is a backfill for an existing employee.
+	Backfill bool
+
+	// The confidentiality of the requisition: confidential or non-confidential.
+	Confidentiality string
+
+	// The compensation band for the requisition.
+	CompensationBand *model.RequisitionCompensationBand
+
+	// The employment status (e.g. full-time, part-time, intern).
+	EmploymentStatus string
+
+	// The user id of the hiring manager for the requisition.
+	HiringManagerID string
+
+	// The user id of the owner of the requisition.
+	OwnerID string
+
+	// Internal notes on the requisition.
+	InternalNotes string
+
+	// The location of the requisition.
+	Location string
+
+	// The department of the requisition.
+	Department string
+
+	// The team of the requisition.
+	Team string
+
+	// Values of custom requisition fields, keyed by requisition field key.
+	CustomFields map[string]any
+}
+
+// Check that the required requisition fields are present. All problems found are returned,
+// joined with [errors.Join].
+func (f *RequisitionFields) Validate() error {
+	var errs []error
+
+	if f.RequisitionCode == "" {
+		errs = append(errs, errors.New("requisition code is required"))
+	}
+
+	if f.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+
+	if !f.HeadcountUnlimited && f.HeadcountTotal <= 0 {
+		errs = append(errs, errors.New("headcount total must be positive unless headcount is unlimited"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Check the custom field values against the account's requisition field definitions, as returned
+// by [Client.ListRequisitionFields]. This checks that every value is for a defined field and has
+// the right type, that dropdown values are among the field's options, that object values only
+// contain defined subfields, and that required fields and subfields have values. All problems
+// found are returned, joined with [errors.Join].
+func (f *RequisitionFields) ValidateCustomFields(definitions []model.RequisitionField) error {
+	return errors.Join(validateRequisitionFieldValues("", definitions, f.CustomFields)...)
+}
+
+// Validate values against requisition field definitions. The prefix is prepended to field ids in
+// error messages to identify subfields.
+func validateRequisitionFieldValues(prefix string, definitions []model.RequisitionField, values map[string]any) []error {
+	var errs []error
+
+	fieldsByID := make(map[string]*model.RequisitionField, len(definitions))
+	for i := range definitions {
+		fieldsByID[definitions[i].ID] = &definitions[i]
+	}
+
+	// Check the values in a stable order so errors are reproducible.
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field, ok := fieldsByID[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("custom field %q is not defined", prefix+key))
+			continue
+		}
+
+		errs = append(errs, validateRequisitionFieldValue(prefix+key, field, values[key])...)
+	}
+
+	for _, field := range definitions {
+		if !field.IsRequired {
+			continue
+		}
+
+		if value, ok := values[field.ID]; !ok || value == nil {
+			errs = append(errs, fmt.Errorf("custom field %q is required", prefix+field.ID))
+		}
+	}
+
+	return errs
+}
+
+// Validate a single value against its requisition field definition.
+func validateRequisitionFieldValue(name string, field *model.RequisitionField, value any) []error {
+	if value == nil {
+		return nil
+	}
+
+	switch field.Type {
+	case model.RequisitionFieldTypeText:
+		if _, ok := value.(string); !ok {
+			return []error{fmt.Errorf("custom field %q must be a string", name)}
+		}
+
+	case model.RequisitionFieldTypeNumber, model.RequisitionFieldTypeDate:
+		switch value.(type) {
+		case int, int32, int64, float32, float64, json.Number:
+		default:
+			return []error{fmt.Errorf("custom field %q must be a number", name)}
+		}
+
+	case model.RequisitionFieldTypeDropdown:
+		text, ok := value.(string)
+		if !ok {
+			return []error{fmt.Errorf("custom field %q must be a string", name)}
+		}
+
+		if field.Option(text) == nil {
+			return []error{fmt.Errorf("custom field %q: %q is not a valid option", name, text)}
+		}
+
+	case model.RequisitionFieldTypeObject:
+		values, ok := value.(map[string]any)
+		if !ok {
+			return []error{fmt.Errorf("custom field %q must be an object", name)}
+		}
+
+		return validateRequisitionFieldValues(name+".", field.Subfields, values)
+	}
+
+	return nil
+}
+
+// JSON body for the requisition create and update requests.
+type requisitionRequestBody struct {
+	RequisitionCode  string                             `json:"requisitionCode"`
+	Name             string                             `json:"name"`
+	HeadcountTotal   any                                `json:"headcountTotal"`
+	Status           string                             `json:"status,omitempty"`
+	Backfill         bool                               `json:"backfill,omitempty"`
+	Confidentiality  string                             `json:"confidentiality,omitempty"`
+	CompensationBand *model.RequisitionCompensationBand `json:"compensationBand,omitempty"`
+	EmploymentStatus string                             `json:"employmentStatus,omitempty"`
+	HiringManagerID  string                             `json:"hiringManager,omitempty"`
+	OwnerID          string                             `json:"owner,omitempty"`
+	InternalNotes    string                             `json:"internalNotes,omitempty"`
+	Location         string                             `json:"location,omitempty"`
+	Department       string                             `json:"department,omitempty"`
+	Team             string                             `json:"team,omitempty"`
+	CustomFields     map[string]any                     `json:"customFields,omitempty"`
+}
+
+// Encode the requisition fields as a JSON request body.
+func (f *RequisitionFields) encode() (io.Reader, error) {
+	body := requisitionRequestBody{
+		RequisitionCode:  f.RequisitionCode,
+		Name:             f.Name,
+		HeadcountTotal:   f.HeadcountTotal,
+		Status:           f.Status,
+		Backfill:         f.Backfill,
+		Confidentiality:  f.Confidentiality,
+		CompensationBand: f.CompensationBand,
+		EmploymentStatus: f.EmploymentStatus,
+		HiringManagerID:  f.HiringManagerID,
+		OwnerID:          f.OwnerID,
+		InternalNotes:    f.InternalNotes,
+		Location:         f.Location,
+		Department:       f.Department,
+		Team:             f.Team,
+		CustomFields:     f.CustomFields,
+	}
+
+	if f.HeadcountUnlimited {
+		body.HeadcountTotal = "unlimited"
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Parameters for creating a requisition.
+type CreateRequisitionRequest struct {
+	BaseRequest
+	RequisitionFields
+}
+
+// Create a new CreateRequisitionRequest with the required fields.
+func NewCreateRequisitionRequest(requisitionCode, name string, headcountTotal int) *CreateRequisitionRequest {
+	return &CreateRequisitionRequest{
+		RequisitionFields: RequisitionFields{
+			RequisitionCode: requisitionCode,
+			Name:            name,
+			HeadcountTotal:  headcountTotal,
+		},
+	}
+}
+
+func (r *CreateRequisitionRequest) GetPath() string {
+	return "requisitions"
+}
+
+func (r *CreateRequisitionRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreateRequisitionRequest) GetBody() (io.Reader, error) {
+	return r.RequisitionFields.encode()
+}
+
+// Response for creating a requisition; returned to client users.
+type CreateRequisitionResponse struct {
+	BaseResponse
+
+	// The requisition record.
+	Requisition *model.Requisition `json:"data"`
+}
+
+// JSON response type for creating a requisition, with some field types dynamically determined.
+type createRequisitionResponseJSON struct {
+	BaseResponse
+
+	// The requisition record.
+	Requisition *multimodel.Requisition `json:"data"`
+}
+
+// Parameters for updating a requisition.
+type UpdateRequisitionRequest struct {
+	BaseRequest
+	RequisitionFields
+
+	// The requisition id. This is required.
+	RequisitionID string
+}
+
+// Create a new UpdateRequisitionRequest with the required fields.
+func NewUpdateRequisitionRequest(requisitionID, requisitionCode, name string, headcountTotal int) *UpdateRequisitionRequest {
+	return &UpdateRequisitionRequest{
+		RequisitionFields: RequisitionFields{
+			RequisitionCode: requisitionCode,
+			Name:            name,
+			HeadcountTotal:  headcountTotal,
+		},
+		RequisitionID: requisitionID,
+	}
+}
+
+// Create a new UpdateRequisitionRequest based on an existing Requisition struct.
+func NewUpdateRequisitionRequestFromRequisition(requisition *model.Requisition) *UpdateRequisitionRequest {
+	return &UpdateRequisitionRequest{
+		RequisitionFields: RequisitionFields{
+			RequisitionCode:    requisition.RequisitionCode,
+			Name:               requisition.Name,
+			HeadcountTotal:     requisition.HeadcountTotal,
+			HeadcountUnlimited: requisition.HeadcountUnlimited,
+			Status:             requisition.Status,
+			Backfill:           requisition.Backfill,
+			Confidentiality:    requisition.Confidentiality,
+			CompensationBand:   requisition.CompensationBand,
+			EmploymentStatus:   requisition.EmploymentStatus,
+			HiringManagerID:    requisition.HiringManagerID,
+			OwnerID:            requisition.OwnerID,
+			InternalNotes:      requisition.InternalNotes,
+			Location:           requisition.Location,
+			Department:         requisition.Department,
+			Team:               requisition.Team,
+			CustomFields:       requisition.CustomFields,
+		},
+		RequisitionID: requisition.ID,
+	}
+}
+
+func (r *UpdateRequisitionRequest) GetPath() string {
+	return fmt.Sprintf("requisitions/%s", url.PathEscape(r.RequisitionID))
+}
+
+func (r *UpdateRequisitionRequest) GetHTTPMethod() string {
+	return http.MethodPut
+}
+
+func (r *UpdateRequisitionRequest) GetBody() (io.Reader, error) {
+	return r.RequisitionFields.encode()
+}
+
+// Response for updating a requisition; returned to client users.
+type UpdateRequisitionResponse struct {
+	BaseResponse
+
+	// The requisition record.
+	Requisition *model.Requisition `json:"data"`
+}
+
+// JSON response type for updating a requisition, with some field types dynamically determined.
+type updateRequisitionResponseJSON struct {
+	BaseResponse
+
+	// The requisition record.
+	Requisition *multimodel.Requisition `json:"data"`
+}
+
+// Parameters for deleting a requisition.
+type DeleteRequisitionRequest struct {
+	BaseRequest
+
+	// The requisition id. This is required.
+	RequisitionID string
+}
+
+// Create a new DeleteRequisitionRequest with the required fields.
+func NewDeleteRequisitionRequest(requisitionID string) *DeleteRequisitionRequest {
+	return &DeleteRequisitionRequest{
+		RequisitionID: requisitionID,
+	}
+}
+
+func (r *DeleteRequisitionRequest) GetPath() string {
+	return fmt.Sprintf("requisitions/%s", url.PathEscape(r.RequisitionID))
+}
+
+func (r *DeleteRequisitionRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+// Response for deleting a requisition.
+type DeleteRequisitionResponse struct {
+	BaseResponse
+}
+
+// Retrieve a single requisition
+func (c *Client) GetRequisition(ctx context.Context, req *GetRequisitionRequest) (*GetRequisitionResponse, error) {
+	var respJSON getRequisitionResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var requisition model.Requisition
+	err := respJSON.Requisition.ToModel(&requisition)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := GetRequisitionResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Requisition:  &requisition,
+	}
+
+	return &resp, nil
+}
+
+// List all requisitions
+//
+// Lists all requisitions in your Lever account.
+func (c *Client) ListRequisitions(ctx context.Context, req *ListRequisitionsRequest) (*ListRequisitionsResponse, error) {
+	var respJSON listRequisitionsResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	requisitions := make([]model.Requisition, len(respJSON.Requisitions))
+	for i := range respJSON.Requisitions {
+		err := respJSON.Requisitions[i].ToModel(&requisitions[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := ListRequisitionsResponse{
+		BaseListResponse: respJSON.BaseListResponse,
+		Requisitions:     requisitions,
+	}
+
+	return &resp, nil
+}
+
+// Create a requisition
+func (c *Client) CreateRequisition(ctx context.Context, req *CreateRequisitionRequest) (*CreateRequisitionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var respJSON createRequisitionResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var requisition model.Requisition
+	err := respJSON.Requisition.ToModel(&requisition)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := CreateRequisitionResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Requisition:  &requisition,
+	}
+
+	return &resp, nil
+}
+
+// Update a requisition
+//
+// This replaces the requisition; all fields must be specified.
+func (c *Client) UpdateRequisition(ctx context.Context, req *UpdateRequisitionRequest) (*UpdateRequisitionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var respJSON updateRequisitionResponseJSON
+	if err := c.exec(ctx, req, &respJSON); err != nil {
+		return nil, err
+	}
+
+	// Convert the response to the client type
+	var requisition model.Requisition
+	err := respJSON.Requisition.ToModel(&requisition)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := UpdateRequisitionResponse{
+		BaseResponse: respJSON.BaseResponse,
+		Requisition:  &requisition,
+	}
+
+	return &resp, nil
+}
+
+// Delete a requisition
+//
+// Deletes a requisition. Only requisitions created via the API can be deleted.
+func (c *Client) DeleteRequisition(ctx context.Context, req *DeleteRequisitionRequest) (*DeleteRequisitionResponse, error) {
+	var resp DeleteRequisitionResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
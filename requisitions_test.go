@@ -0,0 +1,258 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequisitions(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{requisitionEngineer, requisitionSupport}, "hasNext": true, "next": "abc"}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/requisitions"),
+			testclient.ExpectQuery("requisition_code", "ENG-145"),
+			testclient.ExpectQuery("status", "open"),
+			testclient.ExpectQuery("confidentiality", "all"),
+			testclient.ExpectQuery("created_at_start", "1407460069499"),
+			testclient.ExpectQuery("created_at_end", "1417460069499"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSONIndent(map[string]any{"data": requisitionSupport}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/requisitions/4d3a2f1e-0c9b-4a8d-9e7f-6a5b4c3d2e1f"),
+			testclient.ExpectNoQuery(),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Requisition not found"}`,
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/requisitions/00000000-0000-0000-0000-000000000000"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List requisitions with filters
+	createdAtStart := int64(1407460069499)
+	createdAtEnd := int64(1417460069499)
+	listReq := NewListRequisitionsRequest()
+	listReq.RequisitionCodes = []string{"ENG-145"}
+	listReq.Status = model.RequisitionStatusOpen
+	listReq.Confidentiality = "all"
+	listReq.CreatedAtStart = &createdAtStart
+	listReq.CreatedAtEnd = &createdAtEnd
+	listResp, err := c.ListRequisitions(ctx, listReq)
+
+	if ta.NoError(err) && ta.Len(listResp.Requisitions, 2) {
+		ta.True(listResp.HasNext)
+		ta.Equal("abc", listResp.Next)
+
+		req := listResp.Requisitions[0]
+		ta.Equal("8b1f6a3e-2d4c-4f5a-9b8c-7d6e5f4a3b2c", req.ID)
+		ta.Equal("ENG-145", req.RequisitionCode)
+		ta.Equal(model.RequisitionStatusOpen, req.Status)
+		ta.Equal(3, req.HeadcountTotal)
+		ta.False(req.HeadcountUnlimited)
+		ta.Equal(1, req.HeadcountHired)
+		ta.Equal("ecdb6670-d9f3-4b87-8267-1cde26d1bc42", req.HiringManagerID)
+		ta.Equal("df0adaa6-172c-4cd6-8520-49b203660fe1", req.OwnerID)
+		ta.Equal([]string{"f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d"}, req.PostingIDs)
+		if ta.NotNil(req.CompensationBand) && ta.NotNil(req.CompensationBand.Min) {
+			ta.Equal("USD", req.CompensationBand.Currency)
+			ta.Equal(float64(150000), *req.CompensationBand.Min)
+		}
+		if ta.NotNil(req.Approval) && ta.Len(req.Approval.Steps, 1) {
+			ta.Equal("approved", req.Approval.Status)
+			ta.Equal(1, req.Approval.Steps[0].ApprovalsRequired)
+			ta.Equal("022d6639-1333-419b-9635-31f93015335f", req.Approval.Steps[0].Approvers[0].ID)
+		}
+		ta.Equal("Remote", req.CustomFields["work_arrangement"])
+	}
+
+	// Get a requisition with unlimited headcount
+	getResp, err := c.GetRequisition(ctx, NewGetRequisitionRequest("4d3a2f1e-0c9b-4a8d-9e7f-6a5b4c3d2e1f"))
+
+	if ta.NoError(err) {
+		ta.Equal("SUP-001", getResp.Requisition.RequisitionCode)
+		ta.True(getResp.Requisition.HeadcountUnlimited)
+		ta.Equal(0, getResp.Requisition.HeadcountTotal)
+		ta.Nil(getResp.Requisition.CompensationBand)
+		ta.Nil(getResp.Requisition.Approval)
+	}
+
+	// Get a requisition that does not exist
+	getResp, err = c.GetRequisition(ctx, NewGetRequisitionRequest("00000000-0000-0000-0000-000000000000"))
+
+	if ta.Error(err) {
+		ta.Nil(getResp)
+		var leverError *model.LeverError
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestCreateUpdateDeleteRequisition(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": requisitionSupport}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/requisitions"),
+			testclient.ExpectBody(`{"requisitionCode":"SUP-001","name":"Support Specialist","headcountTotal":"unlimited","status":"open","employmentStatus":"full-time","hiringManager":"ecdb6670-d9f3-4b87-8267-1cde26d1bc42","team":"Support"}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": requisitionEngineer}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/requisitions/8b1f6a3e-2d4c-4f5a-9b8c-7d6e5f4a3b2c"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": requisitionEngineer}),
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath("/v1/requisitions/8b1f6a3e-2d4c-4f5a-9b8c-7d6e5f4a3b2c"),
+			testclient.ExpectBody(`{"requisitionCode":"ENG-145","name":"Senior Software Engineer","headcountTotal":4,"status":"open","confidentiality":"non-confidential","compensationBand":{"currency":"USD","interval":"per-year-salary","min":150000,"max":190000},"employmentStatus":"full-time","hiringManager":"ecdb6670-d9f3-4b87-8267-1cde26d1bc42","owner":"df0adaa6-172c-4cd6-8520-49b203660fe1","location":"San Francisco","department":"Engineering","team":"Platform","customFields":{"work_arrangement":"Remote"}}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath("/v1/requisitions/4d3a2f1e-0c9b-4a8d-9e7f-6a5b4c3d2e1f"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Missing required fields are rejected before sending
+	createReq := NewCreateRequisitionRequest("", "Support Specialist", 0)
+	_, err := c.CreateRequisition(ctx, createReq)
+
+	if ta.Error(err) {
+		ta.ErrorContains(err, "requisition code is required")
+		ta.ErrorContains(err, "headcount total must be positive")
+	}
+
+	// Create a requisition with unlimited headcount
+	createReq.RequisitionCode = "SUP-001"
+	createReq.HeadcountUnlimited = true
+	createReq.Status = model.RequisitionStatusOpen
+	createReq.EmploymentStatus = "full-time"
+	createReq.HiringManagerID = "ecdb6670-d9f3-4b87-8267-1cde26d1bc42"
+	createReq.Team = "Support"
+	createResp, err := c.CreateRequisition(ctx, createReq)
+
+	if ta.NoError(err) {
+		ta.Equal("4d3a2f1e-0c9b-4a8d-9e7f-6a5b4c3d2e1f", createResp.Requisition.ID)
+		ta.True(createResp.Requisition.HeadcountUnlimited)
+	}
+
+	// Update a requisition from an existing record
+	getResp, err := c.GetRequisition(ctx, NewGetRequisitionRequest("8b1f6a3e-2d4c-4f5a-9b8c-7d6e5f4a3b2c"))
+
+	if ta.NoError(err) {
+		updateReq := NewUpdateRequisitionRequestFromRequisition(getResp.Requisition)
+		updateReq.HeadcountTotal = 4
+		updateReq.InternalNotes = ""
+		updateResp, err := c.UpdateRequisition(ctx, updateReq)
+
+		if ta.NoError(err) {
+			ta.Equal("8b1f6a3e-2d4c-4f5a-9b8c-7d6e5f4a3b2c", updateResp.Requisition.ID)
+		}
+	}
+
+	// Delete a requisition
+	_, err = c.DeleteRequisition(ctx, NewDeleteRequisitionRequest("4d3a2f1e-0c9b-4a8d-9e7f-6a5b4c3d2e1f"))
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+var requisitionEngineer = map[string]any{
+	"id":               "8b1f6a3e-2d4c-4f5a-9b8c-7d6e5f4a3b2c",
+	"requisitionCode":  "ENG-145",
+	"name":             "Senior Software Engineer",
+	"backfill":         false,
+	"confidentiality":  "non-confidential",
+	"createdAt":        1407460071043,
+	"creator":          "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"employmentStatus": "full-time",
+	"headcountHired":   1,
+	"headcountTotal":   3,
+	"hiringManager":    "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+	"owner":            "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"internalNotes":    "Approved in Q3 planning",
+	"location":         "San Francisco",
+	"department":       "Engineering",
+	"team":             "Platform",
+	"status":           "open",
+	"postings":         []string{"f2f0f1c6-9e2b-4f3a-8c4d-5e6f7a8b9c0d"},
+	"offerIds":         []string{"e3f7c1a9-6b2d-4c8e-9f0a-1b2c3d4e5f60"},
+	"compensationBand": map[string]any{
+		"currency": "USD",
+		"interval": "per-year-salary",
+		"min":      150000,
+		"max":      190000,
+	},
+	"approval": map[string]any{
+		"id":         "7a6b5c4d-3e2f-4a1b-8c9d-0e1f2a3b4c5d",
+		"status":     "approved",
+		"createdBy":  "df0adaa6-172c-4cd6-8520-49b203660fe1",
+		"startedAt":  1407460071043,
+		"approvedAt": 1407560071043,
+		"steps": []map[string]any{
+			{
+				"status":            "approved",
+				"approvalsRequired": 1,
+				"approvers": []map[string]any{
+					{"id": "022d6639-1333-419b-9635-31f93015335f", "status": "approved", "approvedAt": 1407560071043},
+				},
+			},
+		},
+	},
+	"customFields": map[string]any{
+		"work_arrangement": "Remote",
+	},
+}
+
+var requisitionSupport = map[string]any{
+	"id":               "4d3a2f1e-0c9b-4a8d-9e7f-6a5b4c3d2e1f",
+	"requisitionCode":  "SUP-001",
+	"name":             "Support Specialist",
+	"confidentiality":  "non-confidential",
+	"createdAt":        1417460071043,
+	"creator":          "df0adaa6-172c-4cd6-8520-49b203660fe1",
+	"employmentStatus": "full-time",
+	"headcountHired":   0,
+	"headcountTotal":   "unlimited",
+	"hiringManager":    "ecdb6670-d9f3-4b87-8267-1cde26d1bc42",
+	"team":             "Support",
+	"status":           "open",
+	"postings":         []string{},
+	"offerIds":         []string{},
+	"customFields":     map[string]any{},
+}
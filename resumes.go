@@ -2,8 +2,10 @@ package lever
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 
@@ -29,6 +31,16 @@ type ResumesClientInterface interface {
 
 	// Lists all resumes associated with an opportunity in your Lever account.
 	ListResumes(ctx context.Context, req *ListResumesRequest) (*ListResumesResponse, error)
+
+	// Upload a resume.
+	//
+	// Uploads a resume file and attaches it to an opportunity, optionally parsing it.
+	UploadResume(ctx context.Context, req *UploadResumeRequest) (*UploadResumeResponse, error)
+
+	// Delete a resume.
+	//
+	// Deletes a resume from an opportunity.
+	DeleteResume(ctx context.Context, req *DeleteResumeRequest) (*DeleteResumeResponse, error)
 }
 
 // Parameters for retrieving a single resume.
@@ -118,11 +130,11 @@ func (r *ListResumesRequest) AddAPIQueryParams(v *url.Values) {
 	r.BaseListRequest.AddAPIQueryParams(v)
 
 	if r.UploadedAtStart != nil {
-		v.Add("uploadedAtStart", fmt.Sprintf("%d", *r.UploadedAtStart))
+		v.Add(paramUploadedAtStart, fmt.Sprintf("%d", *r.UploadedAtStart))
 	}
 
 	if r.UploadedAtEnd != nil {
-		v.Add("uploadedAtEnd", fmt.Sprintf("%d", *r.UploadedAtEnd))
+		v.Add(paramUploadedAtEnd, fmt.Sprintf("%d", *r.UploadedAtEnd))
 	}
 }
 
@@ -134,6 +146,128 @@ type ListResumesResponse struct {
 	Data []model.Resume `json:"data"`
 }
 
+// Parameters for uploading a resume.
+type UploadResumeRequest struct {
+	BaseRequest
+
+	// The ID of the opportunity to attach the resume to.
+	OpportunityID string
+
+	// Perform this upload on behalf of a specified user. This is required.
+	PerformAsID string
+
+	// If true, parse the resume and update the opportunity's contact information (name, emails,
+	// phones, links, etc.) with the parsed data.
+	Parse bool
+
+	// The resume file to upload. This is required. The file contents are closed after the file
+	// has been sent.
+	File *model.Reader
+
+	// Content type value for the multipart/form-data boundary string
+	contentType string
+}
+
+// Create a new upload resume request with the required fields.
+func NewUploadResumeRequest(performAsID, opportunityID string, file *model.Reader) *UploadResumeRequest {
+	return &UploadResumeRequest{
+		OpportunityID: opportunityID,
+		PerformAsID:   performAsID,
+		File:          file,
+	}
+}
+
+func (r *UploadResumeRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/resumes", url.PathEscape(r.OpportunityID))
+}
+
+func (r *UploadResumeRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *UploadResumeRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+
+	if r.Parse {
+		query.Add(paramParse, "true")
+	}
+}
+
+func (r *UploadResumeRequest) GetBody() (io.Reader, error) {
+	if r.File == nil {
+		return nil, errors.New("file is required")
+	}
+
+	body, contentType := newMultipartBody(r.writeBody)
+	r.contentType = contentType
+	return body, nil
+}
+
+func (r *UploadResumeRequest) GetContentType() string {
+	return r.contentType
+}
+
+// writeBody writes the body of the request to the provided writer.
+func (r *UploadResumeRequest) writeBody(w *multipart.Writer) error {
+	return writeMultipartFile(w, "file", r.File)
+}
+
+// Response for uploading a resume.
+type UploadResumeResponse struct {
+	BaseResponse
+
+	// The uploaded resume.
+	Data model.Resume `json:"data"`
+}
+
+// Parameters for deleting a resume.
+type DeleteResumeRequest struct {
+	BaseRequest
+
+	// The ID of the opportunity associated with the resume.
+	OpportunityID string
+
+	// The ID of the resume to delete.
+	ID string
+
+	// Perform this delete on behalf of a specified user. This is required.
+	PerformAsID string
+}
+
+// Create a new delete resume request with the required fields.
+func NewDeleteResumeRequest(performAsID, opportunityID, id string) *DeleteResumeRequest {
+	return &DeleteResumeRequest{
+		OpportunityID: opportunityID,
+		ID:            id,
+		PerformAsID:   performAsID,
+	}
+}
+
+func (r *DeleteResumeRequest) GetPath() string {
+	return fmt.Sprintf("opportunities/%s/resumes/%s", url.PathEscape(r.OpportunityID), url.PathEscape(r.ID))
+}
+
+func (r *DeleteResumeRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+func (r *DeleteResumeRequest) AddAPIQueryParams(query *url.Values) {
+	r.BaseRequest.AddAPIQueryParams(query)
+
+	if r.PerformAsID != "" {
+		query.Add(paramPerformAs, r.PerformAsID)
+	}
+}
+
+// Response for deleting a resume.
+type DeleteResumeResponse struct {
+	BaseResponse
+}
+
 // Retrieve information about a single resume.
 //
 // This endpoint retrieves the metadata for a single resume. To download a resume, see the
@@ -152,32 +286,38 @@ func (c *Client) GetResume(ctx context.Context, req *GetResumeRequest) (*GetResu
 //
 // Downloads a resume file if it exists
 func (c *Client) DownloadResume(ctx context.Context, req *DownloadResumeRequest) (*http.Response, error) {
-	httpResp, err := c.send(ctx, req)
-	if err != nil {
+	return c.download(ctx, req)
+}
+
+// Lists all resumes associated with an opportunity in your Lever account.
+func (c *Client) ListResumes(ctx context.Context, req *ListResumesRequest) (*ListResumesResponse, error) {
+	var resp ListResumesResponse
+
+	if err := c.exec(ctx, req, &resp); err != nil {
 		return nil, err
 	}
 
-	if httpResp.StatusCode >= 300 {
-		defer httpResp.Body.Close()
-		decoder := json.NewDecoder(httpResp.Body)
-		leverError := &model.LeverError{
-			HTTPResponse: httpResp,
-		}
+	return &resp, nil
+}
 
-		if decoder.Decode(leverError) != nil {
-			leverError.Code = httpResp.Status
-			leverError.Message = fmt.Sprintf("Unexpected HTTP response status code: %d", httpResp.StatusCode)
-		}
+// Upload a resume.
+//
+// Uploads a resume file and attaches it to an opportunity, optionally parsing it.
+func (c *Client) UploadResume(ctx context.Context, req *UploadResumeRequest) (*UploadResumeResponse, error) {
+	var resp UploadResumeResponse
 
-		return nil, leverError
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
 	}
 
-	return httpResp, nil
+	return &resp, nil
 }
 
-// Lists all resumes associated with an opportunity in your Lever account.
-func (c *Client) ListResumes(ctx context.Context, req *ListResumesRequest) (*ListResumesResponse, error) {
-	var resp ListResumesResponse
+// Delete a resume.
+//
+// Deletes a resume from an opportunity.
+func (c *Client) DeleteResume(ctx context.Context, req *DeleteResumeRequest) (*DeleteResumeResponse, error) {
+	var resp DeleteResumeResponse
 
 	if err := c.exec(ctx, req, &resp); err != nil {
 		return nil, err
@@ -0,0 +1,152 @@
+package lever
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumes(t *testing.T) {
+	ta := assert.New(t)
+
+	const resumesPath = "/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/resumes"
+	const performAsID = "df0adaa6-172c-4cd6-8520-49b203660fe1"
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{resumeShaneSmith}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath(resumesPath),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": resumeShaneSmith}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath(resumesPath),
+			testclient.ExpectQuery("perform_as", performAsID),
+			testclient.ExpectQuery("parse", "true"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath(resumesPath+"/5a1f3e2b-7c4d-4e8f-9a0b-1c2d3e4f5a6b"),
+			testclient.ExpectQuery("perform_as", performAsID),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNotFound,
+			`{"code":"ResourceNotFound","message":"Resume was not found"}`,
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath(resumesPath+"/00000000-0000-0000-0000-000000000000"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+	var leverError *model.LeverError
+
+	// List resumes
+	listResp, err := c.ListResumes(ctx, NewListResumesRequest("250d8f03-738a-4bba-a671-8a3d73477145"))
+
+	if ta.NoError(err) && ta.Len(listResp.Data, 1) {
+		ta.Equal("5a1f3e2b-7c4d-4e8f-9a0b-1c2d3e4f5a6b", listResp.Data[0].ID)
+		if ta.NotNil(listResp.Data[0].File) {
+			ta.Equal("resume.pdf", listResp.Data[0].File.Name)
+		}
+	}
+
+	// Upload and parse a resume
+	uploadReq := NewUploadResumeRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", &model.Reader{
+		Name:     "resume.pdf",
+		Contents: io.NopCloser(strings.NewReader("%PDF-1.4 resume")),
+	})
+	uploadReq.Parse = true
+	uploadResp, err := c.UploadResume(ctx, uploadReq)
+
+	if ta.NoError(err) {
+		ta.Equal("5a1f3e2b-7c4d-4e8f-9a0b-1c2d3e4f5a6b", uploadResp.Data.ID)
+	}
+
+	// Delete a resume
+	deleteReq := NewDeleteResumeRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "5a1f3e2b-7c4d-4e8f-9a0b-1c2d3e4f5a6b")
+	_, err = c.DeleteResume(ctx, deleteReq)
+	ta.NoError(err)
+
+	// Delete a resume that does not exist
+	deleteResp, err := c.DeleteResume(ctx, NewDeleteResumeRequest(performAsID, "250d8f03-738a-4bba-a671-8a3d73477145", "00000000-0000-0000-0000-000000000000"))
+
+	if ta.Error(err) {
+		ta.Nil(deleteResp)
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("ResourceNotFound", leverError.Code)
+		}
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestUploadResumeBody(t *testing.T) {
+	ta := assert.New(t)
+
+	req := NewUploadResumeRequest("df0adaa6-172c-4cd6-8520-49b203660fe1", "250d8f03-738a-4bba-a671-8a3d73477145", &model.Reader{
+		Name:     "resume.docx",
+		Contents: io.NopCloser(strings.NewReader("resume contents")),
+		MIMEType: "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	})
+
+	body, err := req.GetBody()
+	if !ta.NoError(err) {
+		return
+	}
+
+	data, err := io.ReadAll(body)
+	if !ta.NoError(err) {
+		return
+	}
+
+	_, params, err := mime.ParseMediaType(req.GetContentType())
+	if !ta.NoError(err) {
+		return
+	}
+
+	form, err := multipart.NewReader(bytes.NewReader(data), params["boundary"]).ReadForm(1 << 20)
+	if !ta.NoError(err) {
+		return
+	}
+
+	if ta.Len(form.File["file"], 1) {
+		ta.Equal("resume.docx", form.File["file"][0].Filename)
+		ta.Equal("application/vnd.openxmlformats-officedocument.wordprocessingml.document", form.File["file"][0].Header.Get("Content-Type"))
+	}
+}
+
+var resumeShaneSmith = map[string]any{
+	"id":        "5a1f3e2b-7c4d-4e8f-9a0b-1c2d3e4f5a6b",
+	"createdAt": 1423187881576,
+	"file": map[string]any{
+		"downloadUrl": "https://api.lever.co/v1/opportunities/250d8f03-738a-4bba-a671-8a3d73477145/resumes/5a1f3e2b-7c4d-4e8f-9a0b-1c2d3e4f5a6b/download",
+		"ext":         "pdf",
+		"name":        "resume.pdf",
+		"uploadedAt":  1423187881576,
+		"status":      "processed",
+		"size":        20480,
+	},
+	"parsedData": map[string]any{
+		"positions": []map[string]any{},
+		"schools":   []map[string]any{},
+	},
+}
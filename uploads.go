@@ -0,0 +1,88 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever uploads client interface
+type UploadsClientInterface interface {
+	ClientInterface
+
+	// Upload a file
+	//
+	// Uploads a file to temporary storage. The returned URI can be referenced by later requests,
+	// such as CreateOpportunity, until the upload expires.
+	UploadFile(ctx context.Context, req *UploadFileRequest) (*UploadFileResponse, error)
+}
+
+// Parameters for uploading a file.
+type UploadFileRequest struct {
+	BaseRequest
+
+	// The file to upload. This is required.
+	File *model.Reader
+
+	// Content type value for the multipart/form-data boundary string
+	contentType string
+}
+
+// Create a new UploadFileRequest with the required fields.
+func NewUploadFileRequest(file *model.Reader) *UploadFileRequest {
+	return &UploadFileRequest{
+		File: file,
+	}
+}
+
+func (r *UploadFileRequest) GetPath() string {
+	return "uploads"
+}
+
+func (r *UploadFileRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *UploadFileRequest) GetBody() (io.Reader, error) {
+	if r.File == nil {
+		return nil, errors.New("file is required")
+	}
+
+	body, contentType := newMultipartBody(r.writeBody)
+	r.contentType = contentType
+	return body, nil
+}
+
+func (r *UploadFileRequest) GetContentType() string {
+	return r.contentType
+}
+
+// writeBody writes the body of the request to the provided writer.
+func (r *UploadFileRequest) writeBody(w *multipart.Writer) error {
+	return writeMultipartFile(w, "file", r.File)
+}
+
+// Response for uploading a file.
+type UploadFileResponse struct {
+	BaseResponse
+
+	// The uploaded file.
+	Upload *model.Upload `json:"data"`
+}
+
+// Upload a file
+//
+// Uploads a file to temporary storage. The returned URI can be referenced by later requests, such
+// as CreateOpportunity, until the upload expires.
+func (c *Client) UploadFile(ctx context.Context, req *UploadFileRequest) (*UploadFileResponse, error) {
+	var resp UploadFileResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
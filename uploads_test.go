@@ -0,0 +1,166 @@
+package lever
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadFile(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": uploadResume}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/uploads"),
+			testclient.ExpectNoQuery(),
+		),
+		testclient.NewExpectHandler(
+			http.StatusRequestEntityTooLarge,
+			`{"code":"RequestEntityTooLarge","message":"File exceeds the maximum upload size"}`,
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/uploads"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Upload a file
+	uploadResp, err := c.UploadFile(ctx, NewUploadFileRequest(&model.Reader{
+		Name:     "resume.pdf",
+		Contents: io.NopCloser(strings.NewReader("%PDF-1.4 resume")),
+	}))
+
+	if ta.NoError(err) && ta.NotNil(uploadResp.Upload) {
+		ta.Equal("https://lever-uploads.example.com/9b1f3c2e/resume.pdf", uploadResp.Upload.URI)
+		ta.Equal("resume.pdf", uploadResp.Upload.Filename)
+		if ta.NotNil(uploadResp.Upload.ExpiresAt) {
+			ta.Equal(int64(1711659000000), *uploadResp.Upload.ExpiresAt)
+		}
+	}
+
+	// Upload a file that is too large
+	uploadResp, err = c.UploadFile(ctx, NewUploadFileRequest(&model.Reader{
+		Name:     "scan.tiff",
+		Contents: io.NopCloser(strings.NewReader("II*")),
+	}))
+
+	if ta.Error(err) {
+		ta.Nil(uploadResp)
+		var leverError *model.LeverError
+		if ta.ErrorAs(err, &leverError) {
+			ta.Equal("RequestEntityTooLarge", leverError.Code)
+		}
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestUploadFileBody(t *testing.T) {
+	ta := assert.New(t)
+
+	req := NewUploadFileRequest(&model.Reader{
+		Name:     "resume.pdf",
+		Contents: io.NopCloser(strings.NewReader("%PDF-1.4 resume")),
+	})
+
+	body, err := req.GetBody()
+	if !ta.NoError(err) {
+		return
+	}
+
+	data, err := io.ReadAll(body)
+	if !ta.NoError(err) {
+		return
+	}
+
+	_, params, err := mime.ParseMediaType(req.GetContentType())
+	if !ta.NoError(err) {
+		return
+	}
+
+	form, err := multipart.NewReader(bytes.NewReader(data), params["boundary"]).ReadForm(1 << 20)
+	if !ta.NoError(err) {
+		return
+	}
+
+	if ta.Len(form.File["file"], 1) {
+		ta.Equal("resume.pdf", form.File["file"][0].Filename)
+		ta.Equal("application/pdf", form.File["file"][0].Header.Get("Content-Type"))
+	}
+
+	// A file is required.
+	req.File = nil
+	_, err = req.GetBody()
+	ta.Error(err)
+}
+
+func TestCreateOpportunityWithUploadsBody(t *testing.T) {
+	ta := assert.New(t)
+
+	req := NewCreateOpportunityRequest("68d28c32-e972-4f0f-81c7-83f2e9430293")
+	req.Name = "Monica Geller"
+	req.ResumeUpload = &model.Upload{URI: "https://lever-uploads.example.com/9b1f3c2e/resume.pdf", Filename: "resume.pdf"}
+	req.Files = []model.Reader{{
+		Name:     "portfolio.pdf",
+		Contents: io.NopCloser(strings.NewReader("%PDF-1.4 portfolio")),
+	}}
+	req.FileUploads = []model.Upload{{URI: "https://lever-uploads.example.com/0c2a4d3f/references.pdf", Filename: "references.pdf"}}
+
+	body, err := req.GetBody()
+	if !ta.NoError(err) {
+		return
+	}
+
+	data, err := io.ReadAll(body)
+	if !ta.NoError(err) {
+		return
+	}
+
+	_, params, err := mime.ParseMediaType(req.GetContentType())
+	if !ta.NoError(err) {
+		return
+	}
+
+	form, err := multipart.NewReader(bytes.NewReader(data), params["boundary"]).ReadForm(1 << 20)
+	if !ta.NoError(err) {
+		return
+	}
+
+	ta.Equal([]string{"https://lever-uploads.example.com/9b1f3c2e/resume.pdf"}, form.Value["resume"])
+	ta.Empty(form.File["resume"])
+	if ta.Len(form.File["files[0]"], 1) {
+		ta.Equal("portfolio.pdf", form.File["files[0]"][0].Filename)
+	}
+	ta.Equal([]string{"https://lever-uploads.example.com/0c2a4d3f/references.pdf"}, form.Value["files[1]"])
+
+	// A resume cannot be both streamed and referenced.
+	req.ResumeFile = &model.Reader{
+		Name:     "resume.pdf",
+		Contents: io.NopCloser(strings.NewReader("%PDF-1.4 resume")),
+	}
+	_, err = req.GetBody()
+	ta.Error(err)
+}
+
+var uploadResume = map[string]any{
+	"uri":       "https://lever-uploads.example.com/9b1f3c2e/resume.pdf",
+	"filename":  "resume.pdf",
+	"expiresAt": 1711659000000,
+}
@@ -1,9 +1,7 @@
 package lever
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -182,15 +180,7 @@ func (r *CreateUserRequest) GetHTTPMethod() string {
 }
 
 func (r *CreateUserRequest) GetBody() (io.Reader, error) {
-	result := bytes.Buffer{}
-	encoder := json.NewEncoder(&result)
-	encoder.SetEscapeHTML(false)
-	encoder.SetIndent("", "")
-	if err := encoder.Encode(r); err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return encodeJSONBody(r)
 }
 
 // Response for creating a user.
@@ -269,15 +259,7 @@ func (r *UpdateUserRequest) GetHTTPMethod() string {
 }
 
 func (r *UpdateUserRequest) GetBody() (io.Reader, error) {
-	result := bytes.Buffer{}
-	encoder := json.NewEncoder(&result)
-	encoder.SetEscapeHTML(false)
-	encoder.SetIndent("", "")
-	if err := encoder.Encode(r); err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return encodeJSONBody(r)
 }
 
 // Response for updating a user.
@@ -1,6 +1,12 @@
 package lever
 
-import "strings"
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"strings"
+)
 
 // From https://github.com/golang/go/blob/0c5612092deb0a50c5a3d67babc1249049595558/src/mime/multipart/writer.go#L132-L136
 var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
@@ -8,3 +14,53 @@ var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
 func escapeQuotes(s string) string {
 	return quoteEscaper.Replace(s)
 }
+
+// Create a streaming multipart/form-data request body. The writeBody function is called in a
+// separate goroutine to write the form fields; any error it returns is passed to the reader of the
+// body. Returns the body and its content type (including the boundary string).
+func newMultipartBody(writeBody func(w *multipart.Writer) error) (io.Reader, string) {
+	reader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+
+	go func() {
+		err := writeBody(writer)
+		if err == nil {
+			err = writer.Close()
+		}
+
+		pipeWriter.CloseWithError(err)
+	}()
+
+	return reader, writer.FormDataContentType()
+}
+
+// Encode a value as a JSON request body.
+func encodeJSONBody(body any) (io.Reader, error) {
+	result := bytes.Buffer{}
+	encoder := json.NewEncoder(&result)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "")
+	if err := encoder.Encode(body); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Returns an empty slice if values is nil, so that it encodes as [] rather than null.
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+// Returns nil if s is empty, so that it encodes as null rather than "".
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// A single webhook delivery from Lever. The event-specific payload is left in Data; registered
+// callbacks receive it decoded into the matching XxxData struct.
+type Delivery struct {
+	// Delivery UID
+	ID string `json:"id"`
+
+	// The event type. One of the model.WebhookEvent constants.
+	Event string `json:"event"`
+
+	// Datetime when the event was triggered, in milliseconds since the Unix epoch.
+	TriggeredAt int64 `json:"triggeredAt"`
+
+	// A random token unique to this delivery. The signature is computed over this token and
+	// TriggeredAt.
+	Token string `json:"token"`
+
+	// Hex-encoded HMAC-SHA256 signature of Token and TriggeredAt, keyed with the webhook's
+	// signature token.
+	Signature string `json:"signature"`
+
+	// The event-specific payload.
+	Data json.RawMessage `json:"data"`
+}
+
+// Payload for applicationCreated events.
+type ApplicationCreatedData struct {
+	// The application that was created.
+	ApplicationID string `json:"applicationId"`
+
+	// The opportunity the application belongs to.
+	OpportunityID string `json:"opportunityId"`
+
+	// The candidate ID. This is the same as the opportunity ID.
+	CandidateID string `json:"candidateId"`
+
+	// The contact the opportunity belongs to.
+	ContactID string `json:"contactId"`
+
+	// The posting applied to, if any.
+	PostingID string `json:"postingId,omitempty"`
+}
+
+// Payload for candidateHired events.
+type CandidateHiredData struct {
+	// The opportunity that was hired.
+	OpportunityID string `json:"opportunityId"`
+
+	// The candidate ID. This is the same as the opportunity ID.
+	CandidateID string `json:"candidateId"`
+
+	// The contact the opportunity belongs to.
+	ContactID string `json:"contactId"`
+}
+
+// Payload for candidateStageChange events.
+type CandidateStageChangeData struct {
+	// The opportunity whose stage changed.
+	OpportunityID string `json:"opportunityId"`
+
+	// The candidate ID. This is the same as the opportunity ID.
+	CandidateID string `json:"candidateId"`
+
+	// The contact the opportunity belongs to.
+	ContactID string `json:"contactId"`
+
+	// The stage the opportunity moved from.
+	FromStageID string `json:"fromStageId"`
+
+	// The stage the opportunity moved to.
+	ToStageID string `json:"toStageId"`
+}
+
+// Payload for candidateArchiveChange events.
+type CandidateArchiveChangeData struct {
+	// The opportunity whose archived status changed.
+	OpportunityID string `json:"opportunityId"`
+
+	// The candidate ID. This is the same as the opportunity ID.
+	CandidateID string `json:"candidateId"`
+
+	// The contact the opportunity belongs to.
+	ContactID string `json:"contactId"`
+
+	// The archived status before the change. Nil if the opportunity was active.
+	FromArchived *model.Archived `json:"fromArchived"`
+
+	// The archived status after the change, including the archive reason ID. Nil if the
+	// opportunity was unarchived.
+	ToArchived *model.Archived `json:"toArchived"`
+}
+
+// Payload for candidateDeleted events.
+type CandidateDeletedData struct {
+	// The opportunity that was deleted.
+	OpportunityID string `json:"opportunityId"`
+
+	// The candidate ID. This is the same as the opportunity ID.
+	CandidateID string `json:"candidateId"`
+
+	// The contact the opportunity belonged to.
+	ContactID string `json:"contactId"`
+
+	// The user ID of the user who deleted the opportunity.
+	DeletedBy string `json:"deletedBy,omitempty"`
+}
+
+// Payload for interviewCreated, interviewUpdated and interviewDeleted events.
+type InterviewData struct {
+	// The interview that changed.
+	InterviewID string `json:"interviewId"`
+
+	// The panel the interview belongs to.
+	PanelID string `json:"panelId"`
+
+	// The opportunity the interview is for.
+	OpportunityID string `json:"opportunityId"`
+
+	// The contact the opportunity belongs to.
+	ContactID string `json:"contactId"`
+}
+
+// Payload for contactCreated and contactUpdated events.
+type ContactData struct {
+	// The contact that changed.
+	ContactID string `json:"contactId"`
+}
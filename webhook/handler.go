@@ -0,0 +1,346 @@
+// Package webhook receives webhook deliveries from Lever.
+//
+// A [Handler] verifies each delivery's signature, rejects stale deliveries, acknowledges replayed
+// deliveries without handling them again, decodes the payload for the event type and calls the
+// callback registered for it:
+//
+//	h := webhook.NewHandler(
+//		webhook.WithSignatureToken(model.WebhookEventCandidateStageChange, stageChangeToken),
+//	)
+//	h.OnCandidateStageChange(func(ctx context.Context, d *webhook.Delivery, data *webhook.CandidateStageChangeData) error {
+//		return moveCandidate(ctx, data.OpportunityID, data.ToStageID)
+//	})
+//	http.Handle("/lever", h)
+//
+// Callbacks that return an error cause a 500 response, so Lever retries the delivery.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// The default maximum age of a delivery. This is longer than Lever's retry schedule, so that
+// retries of a delivery whose callback failed are still accepted.
+const defaultMaxAge = 24 * time.Hour
+
+// The maximum time a delivery may be triggered in the future, to allow for clock skew. Limited to
+// the maximum age if that is shorter.
+const maxClockSkew = 5 * time.Minute
+
+// The default maximum size of a delivery body.
+const defaultMaxBodyBytes = 1 << 20
+
+var (
+	// The delivery signature does not match, or there is no signature token for the event type.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+
+	// The delivery was triggered too long ago (or too far in the future).
+	ErrStaleDelivery = errors.New("stale webhook delivery")
+
+	// The delivery has already been handled.
+	ErrReplayedDelivery = errors.New("replayed webhook delivery")
+)
+
+// An http.Handler for Lever webhook deliveries.
+type Handler struct {
+	// Signature tokens, keyed by event type.
+	signatureTokens map[string]string
+
+	// Signature token for event types without their own token.
+	defaultSignatureToken string
+
+	// The maximum age of a delivery.
+	maxAge time.Duration
+
+	// The maximum size of a delivery body.
+	maxBodyBytes int64
+
+	// Returns the current time.
+	now func() time.Time
+
+	// Callbacks, keyed by event type.
+	callbacks map[string]func(ctx context.Context, delivery *Delivery) error
+
+	// Callback for event types without a registered callback.
+	unhandled func(ctx context.Context, delivery *Delivery) error
+
+	// Tokens of deliveries that have been accepted, with the time they expire from the cache.
+	seenMu sync.Mutex
+	seen   map[string]time.Time
+}
+
+// Create a new handler with the given options.
+func NewHandler(opts ...func(*Handler)) *Handler {
+	h := &Handler{
+		signatureTokens: make(map[string]string),
+		maxAge:          defaultMaxAge,
+		maxBodyBytes:    defaultMaxBodyBytes,
+		now:             time.Now,
+		callbacks:       make(map[string]func(ctx context.Context, delivery *Delivery) error),
+		seen:            make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Option for setting the signature token for an event type. Each Lever webhook has its own
+// signature token, returned when the webhook is created.
+func WithSignatureToken(event, token string) func(*Handler) {
+	return func(h *Handler) {
+		h.signatureTokens[event] = token
+	}
+}
+
+// Option for setting the signature token used for event types without their own token.
+func WithDefaultSignatureToken(token string) func(*Handler) {
+	return func(h *Handler) {
+		h.defaultSignatureToken = token
+	}
+}
+
+// Option for setting how old a delivery may be before it is rejected as stale. Deliveries
+// triggered more than this far (or 5 minutes, if less) in the future are also rejected. The
+// default is 24 hours. Accepted deliveries are remembered for about this long to detect replays,
+// so the maximum age should be longer than Lever takes to give up retrying a failed delivery.
+func WithMaxAge(maxAge time.Duration) func(*Handler) {
+	return func(h *Handler) {
+		h.maxAge = maxAge
+	}
+}
+
+// Option for setting the clock used to check delivery ages.
+func WithClock(now func() time.Time) func(*Handler) {
+	return func(h *Handler) {
+		h.now = now
+	}
+}
+
+// Register a callback for applicationCreated events.
+func (h *Handler) OnApplicationCreated(fn func(ctx context.Context, delivery *Delivery, data *ApplicationCreatedData) error) {
+	on(h, model.WebhookEventApplicationCreated, fn)
+}
+
+// Register a callback for candidateHired events.
+func (h *Handler) OnCandidateHired(fn func(ctx context.Context, delivery *Delivery, data *CandidateHiredData) error) {
+	on(h, model.WebhookEventCandidateHired, fn)
+}
+
+// Register a callback for candidateStageChange events.
+func (h *Handler) OnCandidateStageChange(fn func(ctx context.Context, delivery *Delivery, data *CandidateStageChangeData) error) {
+	on(h, model.WebhookEventCandidateStageChange, fn)
+}
+
+// Register a callback for candidateArchiveChange events.
+func (h *Handler) OnCandidateArchiveChange(fn func(ctx context.Context, delivery *Delivery, data *CandidateArchiveChangeData) error) {
+	on(h, model.WebhookEventCandidateArchiveChange, fn)
+}
+
+// Register a callback for candidateDeleted events.
+func (h *Handler) OnCandidateDeleted(fn func(ctx context.Context, delivery *Delivery, data *CandidateDeletedData) error) {
+	on(h, model.WebhookEventCandidateDeleted, fn)
+}
+
+// Register a callback for interviewCreated, interviewUpdated and interviewDeleted events. Use
+// [Delivery.Event] to tell them apart.
+func (h *Handler) OnInterview(fn func(ctx context.Context, delivery *Delivery, data *InterviewData) error) {
+	on(h, model.WebhookEventInterviewCreated, fn)
+	on(h, model.WebhookEventInterviewUpdated, fn)
+	on(h, model.WebhookEventInterviewDeleted, fn)
+}
+
+// Register a callback for contactCreated and contactUpdated events. Use [Delivery.Event] to tell
+// them apart.
+func (h *Handler) OnContact(fn func(ctx context.Context, delivery *Delivery, data *ContactData) error) {
+	on(h, model.WebhookEventContactCreated, fn)
+	on(h, model.WebhookEventContactUpdated, fn)
+}
+
+// Register a callback for verified deliveries of event types without a registered callback. If
+// none is registered, such deliveries are acknowledged and ignored.
+func (h *Handler) OnUnhandled(fn func(ctx context.Context, delivery *Delivery) error) {
+	h.unhandled = fn
+}
+
+// An error decoding a delivery's payload.
+type decodeError struct {
+	err error
+}
+
+func (e *decodeError) Error() string {
+	return fmt.Sprintf("invalid webhook payload: %v", e.err)
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
+
+// Register a callback that receives the payload decoded into T.
+func on[T any](h *Handler, event string, fn func(ctx context.Context, delivery *Delivery, data *T) error) {
+	h.callbacks[event] = func(ctx context.Context, delivery *Delivery) error {
+		var data T
+		if err := json.Unmarshal(delivery.Data, &data); err != nil {
+			return &decodeError{err: err}
+		}
+
+		return fn(ctx, delivery, &data)
+	}
+}
+
+// Handle a webhook delivery.
+//
+// Responds with 405 if the method is not POST, 400 if the body cannot be decoded, 401 if the
+// signature is invalid, 400 if the delivery is stale, and 500 if the callback returns an error.
+// Otherwise responds with 204, including for deliveries that have already been handled, so that
+// Lever stops retrying them.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var delivery Delivery
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.maxBodyBytes))
+	if err := decoder.Decode(&delivery); err != nil {
+		http.Error(w, fmt.Sprintf("invalid webhook delivery: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Verify(&delivery); err != nil {
+		if errors.Is(err, ErrInvalidSignature) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	if err := h.markSeen(&delivery); err != nil {
+		// The delivery has already been handled; acknowledge it without calling the callback again.
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), &delivery); err != nil {
+		// Forget the delivery so that Lever's retry is accepted.
+		h.forget(&delivery)
+
+		var decodeErr *decodeError
+		if errors.As(err, &decodeErr) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		} else {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Verify a delivery's signature and age. Errors wrap ErrInvalidSignature or ErrStaleDelivery.
+// Verify does not check for replays; ServeHTTP does that separately.
+func (h *Handler) Verify(delivery *Delivery) error {
+	token, ok := h.signatureTokens[delivery.Event]
+	if !ok {
+		token = h.defaultSignatureToken
+	}
+
+	if token == "" {
+		return fmt.Errorf("%w: no signature token for event %q", ErrInvalidSignature, delivery.Event)
+	}
+
+	if !ValidSignature(token, delivery) {
+		return ErrInvalidSignature
+	}
+
+	triggeredAt := time.UnixMilli(delivery.TriggeredAt)
+	if age := h.now().Sub(triggeredAt); age > h.maxAge || age < -h.clockSkew() {
+		return fmt.Errorf("%w: triggered at %s", ErrStaleDelivery, triggeredAt.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// Returns the maximum time a delivery may be triggered in the future.
+func (h *Handler) clockSkew() time.Duration {
+	return min(h.maxAge, maxClockSkew)
+}
+
+// Record a verified delivery so that replays of it are detected. Returns ErrReplayedDelivery if
+// the delivery has already been recorded.
+func (h *Handler) markSeen(delivery *Delivery) error {
+	h.seenMu.Lock()
+	defer h.seenMu.Unlock()
+
+	now := h.now()
+	for seenToken, expiresAt := range h.seen {
+		if now.After(expiresAt) {
+			delete(h.seen, seenToken)
+		}
+	}
+
+	if _, ok := h.seen[delivery.Token]; ok {
+		return ErrReplayedDelivery
+	}
+
+	// Remember the token for as long as a replay could pass Verify. This does not rely on the
+	// delivery's trigger time: a delivery accepted now was triggered at most clockSkew in the
+	// future, so its replays are stale after maxAge plus clockSkew.
+	h.seen[delivery.Token] = now.Add(h.maxAge + h.clockSkew())
+
+	return nil
+}
+
+// Forget a delivery recorded by markSeen.
+func (h *Handler) forget(delivery *Delivery) {
+	h.seenMu.Lock()
+	defer h.seenMu.Unlock()
+
+	delete(h.seen, delivery.Token)
+}
+
+// Call the callback registered for the delivery's event type.
+func (h *Handler) dispatch(ctx context.Context, delivery *Delivery) error {
+	if callback, ok := h.callbacks[delivery.Event]; ok {
+		return callback(ctx, delivery)
+	}
+
+	if h.unhandled != nil {
+		return h.unhandled(ctx, delivery)
+	}
+
+	return nil
+}
+
+// Returns true if the delivery's signature was computed with the given signature token.
+func ValidSignature(signatureToken string, delivery *Delivery) bool {
+	signature, err := hex.DecodeString(delivery.Signature)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(signature, computeSignature(signatureToken, delivery.Token, delivery.TriggeredAt))
+}
+
+// Compute the HMAC-SHA256 signature of a delivery token and trigger time.
+func computeSignature(signatureToken, token string, triggeredAt int64) []byte {
+	mac := hmac.New(sha256.New, []byte(signatureToken))
+	mac.Write([]byte(token + strconv.FormatInt(triggeredAt, 10)))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,327 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSignatureToken = "Ojq7mpd2Aw9BRjkrJ3Q7pj2kGuJ5K7ke"
+
+var testNow = time.UnixMilli(1700000000000)
+
+func newTestHandler(opts ...func(*Handler)) *Handler {
+	opts = append([]func(*Handler){
+		WithDefaultSignatureToken(testSignatureToken),
+		WithClock(func() time.Time { return testNow }),
+	}, opts...)
+
+	return NewHandler(opts...)
+}
+
+func newDelivery(event, token string, triggeredAt int64, data any) map[string]any {
+	return map[string]any{
+		"id":          "5a3d0e4b-0b7e-4c4c-9b6d-2b0b0e0a1c11",
+		"event":       event,
+		"triggeredAt": triggeredAt,
+		"token":       token,
+		"signature":   hex.EncodeToString(computeSignature(testSignatureToken, token, triggeredAt)),
+		"data":        data,
+	}
+}
+
+func post(h http.Handler, delivery any) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(delivery)
+	req := httptest.NewRequest(http.MethodPost, "/lever", bytes.NewReader(body))
+	resp := httptest.NewRecorder()
+	h.ServeHTTP(resp, req)
+	return resp
+}
+
+func TestStageChange(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler()
+
+	var received *CandidateStageChangeData
+	h.OnCandidateStageChange(func(ctx context.Context, delivery *Delivery, data *CandidateStageChangeData) error {
+		ta.Equal(model.WebhookEventCandidateStageChange, delivery.Event)
+		received = data
+		return nil
+	})
+
+	resp := post(h, newDelivery(model.WebhookEventCandidateStageChange, "token-1", testNow.UnixMilli(), map[string]any{
+		"opportunityId": "250d8f03-738a-4bba-a671-8a3d73477145",
+		"candidateId":   "250d8f03-738a-4bba-a671-8a3d73477145",
+		"contactId":     "7f23e772-d2ef-4d6a-8ab3-4e0b5b2a0d2f",
+		"fromStageId":   "lead-new",
+		"toStageId":     "00922a60-7c15-422b-b086-f62000824fd7",
+	}))
+
+	ta.Equal(http.StatusNoContent, resp.Code)
+	if ta.NotNil(received) {
+		ta.Equal("250d8f03-738a-4bba-a671-8a3d73477145", received.OpportunityID)
+		ta.Equal("7f23e772-d2ef-4d6a-8ab3-4e0b5b2a0d2f", received.ContactID)
+		ta.Equal("lead-new", received.FromStageID)
+		ta.Equal("00922a60-7c15-422b-b086-f62000824fd7", received.ToStageID)
+	}
+}
+
+func TestArchiveChange(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler()
+
+	var received *CandidateArchiveChangeData
+	h.OnCandidateArchiveChange(func(ctx context.Context, delivery *Delivery, data *CandidateArchiveChangeData) error {
+		received = data
+		return nil
+	})
+
+	resp := post(h, newDelivery(model.WebhookEventCandidateArchiveChange, "token-1", testNow.UnixMilli(), map[string]any{
+		"opportunityId": "250d8f03-738a-4bba-a671-8a3d73477145",
+		"candidateId":   "250d8f03-738a-4bba-a671-8a3d73477145",
+		"contactId":     "7f23e772-d2ef-4d6a-8ab3-4e0b5b2a0d2f",
+		"fromArchived":  nil,
+		"toArchived": map[string]any{
+			"archivedAt": 1699999999000,
+			"reason":     "63dd55b2-a99f-4e7b-985f-22c7bf80ab42",
+		},
+	}))
+
+	ta.Equal(http.StatusNoContent, resp.Code)
+	if ta.NotNil(received) {
+		ta.Nil(received.FromArchived)
+		if ta.NotNil(received.ToArchived) {
+			ta.Equal("63dd55b2-a99f-4e7b-985f-22c7bf80ab42", received.ToArchived.ReasonID)
+			if ta.NotNil(received.ToArchived.ArchivedAt) {
+				ta.Equal(int64(1699999999000), *received.ToArchived.ArchivedAt)
+			}
+		}
+	}
+}
+
+func TestInterviewAndContactEvents(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler()
+
+	var interviewEvents, contactIDs []string
+	h.OnInterview(func(ctx context.Context, delivery *Delivery, data *InterviewData) error {
+		interviewEvents = append(interviewEvents, delivery.Event)
+		ta.Equal("250d8f03-738a-4bba-a671-8a3d73477145", data.OpportunityID)
+		return nil
+	})
+	h.OnContact(func(ctx context.Context, delivery *Delivery, data *ContactData) error {
+		contactIDs = append(contactIDs, data.ContactID)
+		return nil
+	})
+
+	interview := map[string]any{
+		"interviewId":   "e1b9a3e0-2a5c-4c3f-9b0e-1d6a2a1c0b7d",
+		"panelId":       "1c0e8b6a-7f3d-4e2b-8c5a-3b9d2e4f6a1c",
+		"opportunityId": "250d8f03-738a-4bba-a671-8a3d73477145",
+		"contactId":     "7f23e772-d2ef-4d6a-8ab3-4e0b5b2a0d2f",
+	}
+
+	ta.Equal(http.StatusNoContent, post(h, newDelivery(model.WebhookEventInterviewCreated, "token-1", testNow.UnixMilli(), interview)).Code)
+	ta.Equal(http.StatusNoContent, post(h, newDelivery(model.WebhookEventInterviewDeleted, "token-2", testNow.UnixMilli(), interview)).Code)
+	ta.Equal(http.StatusNoContent, post(h, newDelivery(model.WebhookEventContactUpdated, "token-3", testNow.UnixMilli(), map[string]any{
+		"contactId": "7f23e772-d2ef-4d6a-8ab3-4e0b5b2a0d2f",
+	})).Code)
+
+	ta.Equal([]string{model.WebhookEventInterviewCreated, model.WebhookEventInterviewDeleted}, interviewEvents)
+	ta.Equal([]string{"7f23e772-d2ef-4d6a-8ab3-4e0b5b2a0d2f"}, contactIDs)
+}
+
+func TestUnhandled(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler()
+
+	// Without an OnUnhandled callback, verified deliveries are acknowledged.
+	ta.Equal(http.StatusNoContent, post(h, newDelivery(model.WebhookEventCandidateHired, "token-1", testNow.UnixMilli(), map[string]any{})).Code)
+
+	var events []string
+	h.OnUnhandled(func(ctx context.Context, delivery *Delivery) error {
+		events = append(events, delivery.Event)
+		return nil
+	})
+
+	ta.Equal(http.StatusNoContent, post(h, newDelivery("somethingNew", "token-2", testNow.UnixMilli(), map[string]any{})).Code)
+	ta.Equal([]string{"somethingNew"}, events)
+}
+
+func TestInvalidSignature(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler()
+
+	called := false
+	h.OnCandidateHired(func(ctx context.Context, delivery *Delivery, data *CandidateHiredData) error {
+		called = true
+		return nil
+	})
+
+	delivery := newDelivery(model.WebhookEventCandidateHired, "token-1", testNow.UnixMilli(), map[string]any{})
+
+	// Signature over a different trigger time.
+	delivery["triggeredAt"] = testNow.UnixMilli() - 1
+	ta.Equal(http.StatusUnauthorized, post(h, delivery).Code)
+
+	// Signature that isn't hex.
+	delivery["triggeredAt"] = testNow.UnixMilli()
+	delivery["signature"] = "not-hex"
+	ta.Equal(http.StatusUnauthorized, post(h, delivery).Code)
+
+	ta.False(called)
+}
+
+func TestPerEventSignatureToken(t *testing.T) {
+	ta := assert.New(t)
+	h := NewHandler(
+		WithSignatureToken(model.WebhookEventCandidateHired, testSignatureToken),
+		WithClock(func() time.Time { return testNow }),
+	)
+
+	ta.Equal(http.StatusNoContent, post(h, newDelivery(model.WebhookEventCandidateHired, "token-1", testNow.UnixMilli(), map[string]any{})).Code)
+
+	// No token is configured for this event type.
+	ta.Equal(http.StatusUnauthorized, post(h, newDelivery(model.WebhookEventCandidateDeleted, "token-2", testNow.UnixMilli(), map[string]any{})).Code)
+}
+
+func TestStaleDelivery(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler(WithMaxAge(time.Minute))
+
+	tooOld := testNow.Add(-2 * time.Minute).UnixMilli()
+	ta.Equal(http.StatusBadRequest, post(h, newDelivery(model.WebhookEventCandidateHired, "token-1", tooOld, map[string]any{})).Code)
+
+	tooNew := testNow.Add(2 * time.Minute).UnixMilli()
+	ta.Equal(http.StatusBadRequest, post(h, newDelivery(model.WebhookEventCandidateHired, "token-2", tooNew, map[string]any{})).Code)
+
+	recent := testNow.Add(-30 * time.Second).UnixMilli()
+	ta.Equal(http.StatusNoContent, post(h, newDelivery(model.WebhookEventCandidateHired, "token-3", recent, map[string]any{})).Code)
+}
+
+func TestReplayedDelivery(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler()
+
+	calls := 0
+	h.OnCandidateHired(func(ctx context.Context, delivery *Delivery, data *CandidateHiredData) error {
+		calls++
+		return nil
+	})
+
+	// A duplicate is acknowledged so that Lever stops retrying it, but not handled again.
+	delivery := newDelivery(model.WebhookEventCandidateHired, "token-1", testNow.UnixMilli(), map[string]any{})
+	ta.Equal(http.StatusNoContent, post(h, delivery).Code)
+	ta.Equal(http.StatusNoContent, post(h, delivery).Code)
+	ta.Equal(1, calls)
+}
+
+func TestVerify(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler()
+
+	calls := 0
+	h.OnCandidateHired(func(ctx context.Context, delivery *Delivery, data *CandidateHiredData) error {
+		calls++
+		return nil
+	})
+
+	// Verifying a delivery does not record it, so it is still handled afterwards.
+	triggeredAt := testNow.UnixMilli()
+	verified := &Delivery{
+		Event:       model.WebhookEventCandidateHired,
+		TriggeredAt: triggeredAt,
+		Token:       "token-1",
+		Signature:   hex.EncodeToString(computeSignature(testSignatureToken, "token-1", triggeredAt)),
+	}
+	ta.NoError(h.Verify(verified))
+	ta.NoError(h.Verify(verified))
+
+	ta.Equal(http.StatusNoContent, post(h, newDelivery(model.WebhookEventCandidateHired, "token-1", triggeredAt, map[string]any{})).Code)
+	ta.Equal(1, calls)
+
+	triggeredAt = testNow.Add(-48 * time.Hour).UnixMilli()
+	stale := &Delivery{
+		Event:       model.WebhookEventCandidateHired,
+		TriggeredAt: triggeredAt,
+		Token:       "token-2",
+		Signature:   hex.EncodeToString(computeSignature(testSignatureToken, "token-2", triggeredAt)),
+	}
+	ta.ErrorIs(h.Verify(stale), ErrStaleDelivery)
+}
+
+func TestCallbackError(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler()
+
+	calls := 0
+	h.OnCandidateHired(func(ctx context.Context, delivery *Delivery, data *CandidateHiredData) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	// A failed delivery can be retried.
+	delivery := newDelivery(model.WebhookEventCandidateHired, "token-1", testNow.UnixMilli(), map[string]any{})
+	ta.Equal(http.StatusInternalServerError, post(h, delivery).Code)
+	ta.Equal(http.StatusNoContent, post(h, delivery).Code)
+	ta.Equal(2, calls)
+}
+
+func TestRetryAfterCallbackError(t *testing.T) {
+	ta := assert.New(t)
+
+	now := testNow
+	h := newTestHandler(WithClock(func() time.Time { return now }))
+
+	calls := 0
+	h.OnCandidateHired(func(ctx context.Context, delivery *Delivery, data *CandidateHiredData) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	// Lever retries a failed delivery well after it was triggered; the retry is still accepted.
+	delivery := newDelivery(model.WebhookEventCandidateHired, "token-1", testNow.UnixMilli(), map[string]any{})
+	ta.Equal(http.StatusInternalServerError, post(h, delivery).Code)
+
+	now = testNow.Add(2 * time.Hour)
+	ta.Equal(http.StatusNoContent, post(h, delivery).Code)
+	ta.Equal(http.StatusNoContent, post(h, delivery).Code)
+	ta.Equal(2, calls)
+}
+
+func TestMalformedRequests(t *testing.T) {
+	ta := assert.New(t)
+	h := newTestHandler()
+	h.OnCandidateStageChange(func(ctx context.Context, delivery *Delivery, data *CandidateStageChangeData) error {
+		return nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/lever", nil)
+	resp := httptest.NewRecorder()
+	h.ServeHTTP(resp, req)
+	ta.Equal(http.StatusMethodNotAllowed, resp.Code)
+	ta.Equal(http.MethodPost, resp.Header().Get("Allow"))
+
+	req = httptest.NewRequest(http.MethodPost, "/lever", bytes.NewReader([]byte("{")))
+	resp = httptest.NewRecorder()
+	h.ServeHTTP(resp, req)
+	ta.Equal(http.StatusBadRequest, resp.Code)
+
+	// Payload of the wrong shape for the event type.
+	ta.Equal(http.StatusBadRequest, post(h, newDelivery(model.WebhookEventCandidateStageChange, "token-1", testNow.UnixMilli(), []string{"x"})).Code)
+}
@@ -0,0 +1,290 @@
+package lever
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/corbaltcode/lever-data-api-go/model"
+)
+
+// Lever webhooks client interface
+type WebhooksClientInterface interface {
+	ClientInterface
+
+	// List all webhooks
+	//
+	// Lists all webhooks in your Lever account, for all event types.
+	ListWebhooks(ctx context.Context, req *ListWebhooksRequest) (*ListWebhooksResponse, error)
+
+	// Create a webhook
+	//
+	// Creates a webhook for a single event type. The response includes the signature token used
+	// to sign deliveries.
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*CreateWebhookResponse, error)
+
+	// Update a webhook
+	//
+	// This replaces the webhook; all fields must be specified.
+	UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+
+	// Delete a webhook
+	DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+}
+
+// Parameters for listing webhooks.
+type ListWebhooksRequest struct {
+	BaseListRequest
+}
+
+// Create a new ListWebhooksRequest with the required fields.
+func NewListWebhooksRequest() *ListWebhooksRequest {
+	return &ListWebhooksRequest{}
+}
+
+func (r *ListWebhooksRequest) GetPath() string {
+	return "webhooks"
+}
+
+// Response for listing webhooks.
+type ListWebhooksResponse struct {
+	BaseListResponse
+
+	// The webhook records.
+	Webhooks []model.Webhook `json:"data"`
+}
+
+// Fields common to webhook create and update requests.
+type WebhookFields struct {
+	// The event type that triggers the webhook. One of the model.WebhookEvent constants. This is
+	// required.
+	Event string
+
+	// The URL to deliver events to. This must be an absolute https URL. This is required.
+	URL string
+
+	// Whether the webhook is enabled.
+	Enabled bool
+
+	// Conditions an event must meet for the webhook to be triggered. If nil, the webhook is
+	// triggered for all events of its type.
+	Conditions *model.WebhookConditions
+}
+
+// Check that the required webhook fields are present. All problems found are returned, joined
+// with [errors.Join].
+func (f *WebhookFields) Validate() error {
+	var errs []error
+
+	if f.Event == "" {
+		errs = append(errs, errors.New("event is required"))
+	}
+
+	if f.URL == "" {
+		errs = append(errs, errors.New("url is required"))
+	} else if u, err := url.Parse(f.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		errs = append(errs, fmt.Errorf("url %q must be an absolute https URL", f.URL))
+	}
+
+	return errors.Join(errs...)
+}
+
+// JSON body for the webhook create and update requests.
+type webhookRequestBody struct {
+	Event         string                      `json:"event"`
+	URL           string                      `json:"url"`
+	Enabled       bool                        `json:"enabled"`
+	Configuration *model.WebhookConfiguration `json:"configuration,omitempty"`
+}
+
+// Encode the webhook fields as a JSON request body.
+func (f *WebhookFields) encode() (io.Reader, error) {
+	body := webhookRequestBody{
+		Event:   f.Event,
+		URL:     f.URL,
+		Enabled: f.Enabled,
+	}
+
+	if f.Conditions != nil {
+		body.Configuration = &model.WebhookConfiguration{
+			Conditions: f.Conditions,
+		}
+	}
+
+	return encodeJSONBody(body)
+}
+
+// Parameters for creating a webhook.
+type CreateWebhookRequest struct {
+	BaseRequest
+	WebhookFields
+}
+
+// Create a new CreateWebhookRequest with the required fields. The webhook is enabled.
+func NewCreateWebhookRequest(event, webhookURL string) *CreateWebhookRequest {
+	return &CreateWebhookRequest{
+		WebhookFields: WebhookFields{
+			Event:   event,
+			URL:     webhookURL,
+			Enabled: true,
+		},
+	}
+}
+
+func (r *CreateWebhookRequest) GetPath() string {
+	return "webhooks"
+}
+
+func (r *CreateWebhookRequest) GetHTTPMethod() string {
+	return http.MethodPost
+}
+
+func (r *CreateWebhookRequest) GetBody() (io.Reader, error) {
+	return r.WebhookFields.encode()
+}
+
+// Response for creating a webhook.
+type CreateWebhookResponse struct {
+	BaseResponse
+
+	// The webhook record.
+	Webhook *model.Webhook `json:"data"`
+}
+
+// Parameters for updating a webhook.
+type UpdateWebhookRequest struct {
+	BaseRequest
+	WebhookFields
+
+	// The webhook id. This is required.
+	WebhookID string
+}
+
+// Create a new UpdateWebhookRequest with the required fields. The webhook is enabled.
+func NewUpdateWebhookRequest(webhookID, event, webhookURL string) *UpdateWebhookRequest {
+	return &UpdateWebhookRequest{
+		WebhookFields: WebhookFields{
+			Event:   event,
+			URL:     webhookURL,
+			Enabled: true,
+		},
+		WebhookID: webhookID,
+	}
+}
+
+// Create a new UpdateWebhookRequest based on an existing Webhook struct.
+func NewUpdateWebhookRequestFromWebhook(webhook *model.Webhook) *UpdateWebhookRequest {
+	req := NewUpdateWebhookRequest(webhook.ID, webhook.Event, webhook.URL)
+	req.Enabled = webhook.Enabled
+	if webhook.Configuration != nil {
+		req.Conditions = webhook.Configuration.Conditions
+	}
+
+	return req
+}
+
+func (r *UpdateWebhookRequest) GetPath() string {
+	return fmt.Sprintf("webhooks/%s", url.PathEscape(r.WebhookID))
+}
+
+func (r *UpdateWebhookRequest) GetHTTPMethod() string {
+	return http.MethodPut
+}
+
+func (r *UpdateWebhookRequest) GetBody() (io.Reader, error) {
+	return r.WebhookFields.encode()
+}
+
+// Response for updating a webhook.
+type UpdateWebhookResponse struct {
+	BaseResponse
+
+	// The webhook record.
+	Webhook *model.Webhook `json:"data"`
+}
+
+// Parameters for deleting a webhook.
+type DeleteWebhookRequest struct {
+	BaseRequest
+
+	// The webhook id. This is required.
+	WebhookID string
+}
+
+// Create a new DeleteWebhookRequest with the required fields.
+func NewDeleteWebhookRequest(webhookID string) *DeleteWebhookRequest {
+	return &DeleteWebhookRequest{
+		WebhookID: webhookID,
+	}
+}
+
+func (r *DeleteWebhookRequest) GetPath() string {
+	return fmt.Sprintf("webhooks/%s", url.PathEscape(r.WebhookID))
+}
+
+func (r *DeleteWebhookRequest) GetHTTPMethod() string {
+	return http.MethodDelete
+}
+
+// Response for deleting a webhook.
+type DeleteWebhookResponse struct {
+	BaseResponse
+}
+
+// List all webhooks
+//
+// Lists all webhooks in your Lever account, for all event types.
+func (c *Client) ListWebhooks(ctx context.Context, req *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	var resp ListWebhooksResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Create a webhook
+//
+// Creates a webhook for a single event type. The response includes the signature token used to
+// sign deliveries.
+func (c *Client) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp CreateWebhookResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Update a webhook
+//
+// This replaces the webhook; all fields must be specified.
+func (c *Client) UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp UpdateWebhookResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Delete a webhook
+func (c *Client) DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	var resp DeleteWebhookResponse
+	if err := c.exec(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,150 @@
+package lever
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/corbaltcode/lever-data-api-go/internal/testclient"
+	"github.com/corbaltcode/lever-data-api-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooks(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": []map[string]any{webhookStageChange, webhookHired}, "hasNext": false}),
+			testclient.ExpectMethod(http.MethodGet),
+			testclient.ExpectPath("/v1/webhooks"),
+			testclient.ExpectNoQuery(),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// List webhooks
+	listResp, err := c.ListWebhooks(ctx, NewListWebhooksRequest())
+
+	if ta.NoError(err) && ta.Len(listResp.Webhooks, 2) {
+		webhook := listResp.Webhooks[0]
+		ta.Equal("6d4e2f1a-3b5c-4d7e-8f9a-0b1c2d3e4f5a", webhook.ID)
+		ta.Equal(model.WebhookEventCandidateStageChange, webhook.Event)
+		ta.Equal("https://hooks.example.com/lever/stage-change", webhook.URL)
+		ta.True(webhook.Enabled)
+		ta.Equal("b1946ac92492d2347c6235b4d2611184", webhook.SignatureToken)
+		if ta.NotNil(webhook.Configuration) && ta.NotNil(webhook.Configuration.Conditions) {
+			ta.Equal([]string{"applied", "referred"}, webhook.Configuration.Conditions.Origins)
+			ta.Equal("non-confidential", webhook.Configuration.Conditions.Confidentiality)
+		}
+
+		webhook = listResp.Webhooks[1]
+		ta.Equal(model.WebhookEventCandidateHired, webhook.Event)
+		ta.False(webhook.Enabled)
+		ta.Nil(webhook.Configuration)
+	}
+
+	ta.Empty(s.Expected)
+}
+
+func TestCreateUpdateDeleteWebhook(t *testing.T) {
+	ta := assert.New(t)
+
+	s := testclient.NewExpectManyHandler(
+		testclient.NewExpectHandler(
+			http.StatusCreated,
+			toJSON(map[string]any{"data": webhookStageChange}),
+			testclient.ExpectMethod(http.MethodPost),
+			testclient.ExpectPath("/v1/webhooks"),
+			testclient.ExpectBody(`{"event":"candidateStageChange","url":"https://hooks.example.com/lever/stage-change","enabled":true,"configuration":{"conditions":{"origins":["applied","referred"],"confidentiality":"non-confidential"}}}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusOK,
+			toJSON(map[string]any{"data": webhookStageChange}),
+			testclient.ExpectMethod(http.MethodPut),
+			testclient.ExpectPath("/v1/webhooks/6d4e2f1a-3b5c-4d7e-8f9a-0b1c2d3e4f5a"),
+			testclient.ExpectBody(`{"event":"candidateStageChange","url":"https://hooks.example.com/lever/stage-change?env=staging","enabled":false,"configuration":{"conditions":{"origins":["applied","referred"],"confidentiality":"non-confidential"}}}`+"\n"),
+		),
+		testclient.NewExpectHandler(
+			http.StatusNoContent,
+			"",
+			testclient.ExpectMethod(http.MethodDelete),
+			testclient.ExpectPath("/v1/webhooks/6d4e2f1a-3b5c-4d7e-8f9a-0b1c2d3e4f5a"),
+		),
+	)
+
+	httpClient := http.Client{
+		Transport: s,
+	}
+
+	c := NewClient(WithHTTPClient(&httpClient))
+	ctx := context.Background()
+
+	// Webhook URLs must be https
+	_, err := c.CreateWebhook(ctx, NewCreateWebhookRequest(model.WebhookEventCandidateStageChange, "http://hooks.example.com/lever"))
+	ta.ErrorContains(err, "must be an absolute https URL")
+
+	_, err = c.CreateWebhook(ctx, NewCreateWebhookRequest("", ""))
+	if ta.Error(err) {
+		ta.ErrorContains(err, "event is required")
+		ta.ErrorContains(err, "url is required")
+	}
+
+	// Create a webhook with conditions
+	createReq := NewCreateWebhookRequest(model.WebhookEventCandidateStageChange, "https://hooks.example.com/lever/stage-change")
+	createReq.Conditions = &model.WebhookConditions{
+		Origins:         []string{"applied", "referred"},
+		Confidentiality: "non-confidential",
+	}
+	createResp, err := c.CreateWebhook(ctx, createReq)
+
+	if ta.NoError(err) {
+		ta.Equal("6d4e2f1a-3b5c-4d7e-8f9a-0b1c2d3e4f5a", createResp.Webhook.ID)
+		ta.Equal("b1946ac92492d2347c6235b4d2611184", createResp.Webhook.SignatureToken)
+
+		// Disable the webhook and point it somewhere else
+		updateReq := NewUpdateWebhookRequestFromWebhook(createResp.Webhook)
+		updateReq.URL = "https://hooks.example.com/lever/stage-change?env=staging"
+		updateReq.Enabled = false
+		_, err = c.UpdateWebhook(ctx, updateReq)
+		ta.NoError(err)
+	}
+
+	// Delete a webhook
+	_, err = c.DeleteWebhook(ctx, NewDeleteWebhookRequest("6d4e2f1a-3b5c-4d7e-8f9a-0b1c2d3e4f5a"))
+	ta.NoError(err)
+
+	ta.Empty(s.Expected)
+}
+
+var webhookStageChange = map[string]any{
+	"id":      "6d4e2f1a-3b5c-4d7e-8f9a-0b1c2d3e4f5a",
+	"event":   "candidateStageChange",
+	"url":     "https://hooks.example.com/lever/stage-change",
+	"enabled": true,
+	"configuration": map[string]any{
+		"conditions": map[string]any{
+			"origins":         []string{"applied", "referred"},
+			"confidentiality": "non-confidential",
+		},
+	},
+	"signatureToken": "b1946ac92492d2347c6235b4d2611184",
+	"createdAt":      1697300000000,
+	"updatedAt":      1697300000000,
+}
+
+var webhookHired = map[string]any{
+	"id":             "7e5f3a2b-4c6d-4e8f-9a0b-1c2d3e4f5a6b",
+	"event":          "candidateHired",
+	"url":            "https://hooks.example.com/lever/hired",
+	"enabled":        false,
+	"signatureToken": "5d41402abc4b2a76b9719d911017c592",
+	"createdAt":      1697400000000,
+}